@@ -0,0 +1,145 @@
+package vectors
+
+// IAABB2D is the interface for an axis-aligned bounding box in 2D space.
+type IAABB2D interface {
+	Contains(point Vector2) bool
+	Intersects(other AABB2D) bool
+	Union(other AABB2D)
+	Expand(amount float64)
+	Center() Vector2
+	Size() Vector2
+	Encapsulate(point Vector2)
+}
+
+// AABB2D represents an axis-aligned bounding box in 2D space, spanning from Min to Max.
+type AABB2D struct {
+	Min Vector2
+	Max Vector2
+}
+
+var _ IAABB2D = (*AABB2D)(nil)
+
+// NewAABB2D creates a new bounding box from its minimum and maximum corners.
+func NewAABB2D(minValue, maxValue Vector2) AABB2D {
+	return AABB2D{
+		Min: minValue,
+		Max: maxValue,
+	}
+}
+
+// Contains checks if a point is inside the box, including points on its boundary.
+func (b AABB2D) Contains(point Vector2) bool {
+	return point.X >= b.Min.X && point.X <= b.Max.X &&
+		point.Y >= b.Min.Y && point.Y <= b.Max.Y
+}
+
+// Intersects checks if the box overlaps another box, including boxes that only touch.
+func (b AABB2D) Intersects(other AABB2D) bool {
+	return b.Min.X <= other.Max.X && b.Max.X >= other.Min.X &&
+		b.Min.Y <= other.Max.Y && b.Max.Y >= other.Min.Y
+}
+
+// Union grows the box to also enclose another box.
+func (b *AABB2D) Union(other AABB2D) {
+	b.Min.ComponentMin(other.Min)
+	b.Max.ComponentMax(other.Max)
+}
+
+// Expand grows the box by an amount in every direction.
+// A negative amount shrinks the box.
+func (b *AABB2D) Expand(amount float64) {
+	b.Min.Sub(Vector2{X: amount, Y: amount})
+	b.Max.Add(Vector2{X: amount, Y: amount})
+}
+
+// Center returns the center point of the box.
+func (b AABB2D) Center() Vector2 {
+	center := b.Min.Added(b.Max)
+	center.Scale(0.5)
+
+	return center
+}
+
+// Size returns the extent of the box along each axis.
+func (b AABB2D) Size() Vector2 {
+	return b.Max.Subbed(b.Min)
+}
+
+// Encapsulate grows the box to also enclose a point.
+func (b *AABB2D) Encapsulate(point Vector2) {
+	b.Min.ComponentMin(point)
+	b.Max.ComponentMax(point)
+}
+
+// IAABB3D is the interface for an axis-aligned bounding box in 3D space.
+type IAABB3D interface {
+	Contains(point Vector3) bool
+	Intersects(other AABB3D) bool
+	Union(other AABB3D)
+	Expand(amount float64)
+	Center() Vector3
+	Size() Vector3
+	Encapsulate(point Vector3)
+}
+
+// AABB3D represents an axis-aligned bounding box in 3D space, spanning from Min to Max.
+type AABB3D struct {
+	Min Vector3
+	Max Vector3
+}
+
+var _ IAABB3D = (*AABB3D)(nil)
+
+// NewAABB3D creates a new bounding box from its minimum and maximum corners.
+func NewAABB3D(minValue, maxValue Vector3) AABB3D {
+	return AABB3D{
+		Min: minValue,
+		Max: maxValue,
+	}
+}
+
+// Contains checks if a point is inside the box, including points on its boundary.
+func (b AABB3D) Contains(point Vector3) bool {
+	return point.X >= b.Min.X && point.X <= b.Max.X &&
+		point.Y >= b.Min.Y && point.Y <= b.Max.Y &&
+		point.Z >= b.Min.Z && point.Z <= b.Max.Z
+}
+
+// Intersects checks if the box overlaps another box, including boxes that only touch.
+func (b AABB3D) Intersects(other AABB3D) bool {
+	return b.Min.X <= other.Max.X && b.Max.X >= other.Min.X &&
+		b.Min.Y <= other.Max.Y && b.Max.Y >= other.Min.Y &&
+		b.Min.Z <= other.Max.Z && b.Max.Z >= other.Min.Z
+}
+
+// Union grows the box to also enclose another box.
+func (b *AABB3D) Union(other AABB3D) {
+	b.Min.ComponentMin(other.Min)
+	b.Max.ComponentMax(other.Max)
+}
+
+// Expand grows the box by an amount in every direction.
+// A negative amount shrinks the box.
+func (b *AABB3D) Expand(amount float64) {
+	b.Min.Sub(Vector3{X: amount, Y: amount, Z: amount})
+	b.Max.Add(Vector3{X: amount, Y: amount, Z: amount})
+}
+
+// Center returns the center point of the box.
+func (b AABB3D) Center() Vector3 {
+	center := b.Min.Added(b.Max)
+	center.Scale(0.5)
+
+	return center
+}
+
+// Size returns the extent of the box along each axis.
+func (b AABB3D) Size() Vector3 {
+	return b.Max.Subbed(b.Min)
+}
+
+// Encapsulate grows the box to also enclose a point.
+func (b *AABB3D) Encapsulate(point Vector3) {
+	b.Min.ComponentMin(point)
+	b.Max.ComponentMax(point)
+}
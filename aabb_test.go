@@ -0,0 +1,158 @@
+package vectors
+
+import (
+	"testing"
+)
+
+func TestAABB2DContains(t *testing.T) {
+	box := NewAABB2D(Vector2{X: -1, Y: 0}, Vector2{X: 1, Y: 2})
+
+	tests := []struct {
+		name  string
+		point Vector2
+		want  bool
+	}{
+		{"inside", Vector2{X: 0, Y: 1}, true},
+		{"on an edge", Vector2{X: 1, Y: 1}, true},
+		{"on a corner", Vector2{X: -1, Y: 0}, true},
+		{"left", Vector2{X: -2, Y: 1}, false},
+		{"above", Vector2{X: 0, Y: 3}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := box.Contains(tt.point); got != tt.want {
+				t.Errorf("Contains(%v) = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAABB2DIntersects(t *testing.T) {
+	box := NewAABB2D(Vector2{X: 0, Y: 0}, Vector2{X: 2, Y: 2})
+
+	tests := []struct {
+		name  string
+		other AABB2D
+		want  bool
+	}{
+		{"overlapping", NewAABB2D(Vector2{X: 1, Y: 1}, Vector2{X: 3, Y: 3}), true},
+		{"enclosed", NewAABB2D(Vector2{X: 0.5, Y: 0.5}, Vector2{X: 1, Y: 1}), true},
+		{"touching", NewAABB2D(Vector2{X: 2, Y: 0}, Vector2{X: 3, Y: 1}), true},
+		{"separated on X", NewAABB2D(Vector2{X: 3, Y: 0}, Vector2{X: 4, Y: 2}), false},
+		{"separated on Y", NewAABB2D(Vector2{X: 0, Y: -3}, Vector2{X: 2, Y: -1}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := box.Intersects(tt.other); got != tt.want {
+				t.Errorf("Intersects(%v) = %v, want %v", tt.other, got, tt.want)
+			}
+
+			if got := tt.other.Intersects(box); got != tt.want {
+				t.Errorf("Intersects is not symmetric for %v", tt.other)
+			}
+		})
+	}
+}
+
+func TestAABB2DGrowing(t *testing.T) {
+	box := NewAABB2D(Vector2{X: 0, Y: 0}, Vector2{X: 2, Y: 2})
+
+	box.Union(NewAABB2D(Vector2{X: 1, Y: -1}, Vector2{X: 4, Y: 1}))
+
+	if want := NewAABB2D(Vector2{X: 0, Y: -1}, Vector2{X: 4, Y: 2}); box != want {
+		t.Errorf("Union() = %v, want %v", box, want)
+	}
+
+	box.Encapsulate(Vector2{X: -2, Y: 5})
+
+	if want := NewAABB2D(Vector2{X: -2, Y: -1}, Vector2{X: 4, Y: 5}); box != want {
+		t.Errorf("Encapsulate() = %v, want %v", box, want)
+	}
+
+	box.Expand(1)
+
+	if want := NewAABB2D(Vector2{X: -3, Y: -2}, Vector2{X: 5, Y: 6}); box != want {
+		t.Errorf("Expand(1) = %v, want %v", box, want)
+	}
+
+	if got, want := box.Center(), (Vector2{X: 1, Y: 2}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Center() = %v, want %v", got, want)
+	}
+
+	if got, want := box.Size(), (Vector2{X: 8, Y: 8}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Size() = %v, want %v", got, want)
+	}
+}
+
+func TestAABB3DContains(t *testing.T) {
+	box := NewAABB3D(Vector3{X: -1, Y: -1, Z: -1}, Vector3{X: 1, Y: 1, Z: 1})
+
+	tests := []struct {
+		name  string
+		point Vector3
+		want  bool
+	}{
+		{"inside", Vector3{}, true},
+		{"on a face", Vector3{Z: 1}, true},
+		{"on a corner", Vector3{X: 1, Y: -1, Z: 1}, true},
+		{"outside on Z", Vector3{Z: 1.5}, false},
+		{"outside on all axes", Vector3{X: 2, Y: 2, Z: 2}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := box.Contains(tt.point); got != tt.want {
+				t.Errorf("Contains(%v) = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAABB3DIntersects(t *testing.T) {
+	box := NewAABB3D(Vector3{}, Vector3{X: 2, Y: 2, Z: 2})
+
+	tests := []struct {
+		name  string
+		other AABB3D
+		want  bool
+	}{
+		{"overlapping", NewAABB3D(Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 3, Y: 3, Z: 3}), true},
+		{"touching", NewAABB3D(Vector3{Z: 2}, Vector3{X: 1, Y: 1, Z: 3}), true},
+		{"separated on Z", NewAABB3D(Vector3{Z: 3}, Vector3{X: 2, Y: 2, Z: 4}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := box.Intersects(tt.other); got != tt.want {
+				t.Errorf("Intersects(%v) = %v, want %v", tt.other, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAABB3DGrowing(t *testing.T) {
+	box := NewAABB3D(Vector3{}, Vector3{X: 2, Y: 2, Z: 2})
+
+	box.Union(NewAABB3D(Vector3{X: 1, Y: -1, Z: 1}, Vector3{X: 4, Y: 1, Z: 1}))
+	box.Encapsulate(Vector3{Z: 6})
+
+	if want := NewAABB3D(Vector3{X: 0, Y: -1, Z: 0}, Vector3{X: 4, Y: 2, Z: 6}); box != want {
+		t.Errorf("Union() and Encapsulate() = %v, want %v", box, want)
+	}
+
+	box.Expand(-0.5)
+
+	if want := NewAABB3D(Vector3{X: 0.5, Y: -0.5, Z: 0.5}, Vector3{X: 3.5, Y: 1.5, Z: 5.5}); box != want {
+		t.Errorf("Expand(-0.5) = %v, want %v", box, want)
+	}
+
+	if got, want := box.Center(), (Vector3{X: 2, Y: 0.5, Z: 3}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Center() = %v, want %v", got, want)
+	}
+
+	if got, want := box.Size(), (Vector3{X: 3, Y: 2, Z: 5}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Size() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package vectors
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// MinEnclosingCircle returns the smallest circle containing all points, using Welzl's algorithm.
+// The points are visited in a shuffled order, which is seeded deterministically,
+// so the same input always gives the same result.
+// It returns a radius of 0 for an empty slice or a single point.
+func MinEnclosingCircle(points []Vector2) (center Vector2, radius float64) {
+	shuffled := slices.Clone(points)
+	rng := rand.New(rand.NewPCG(uint64(len(points)), 0))
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	for i, p := range shuffled {
+		if circleContains(center, radius, p) {
+			continue
+		}
+
+		center, radius = p, 0
+
+		for j, q := range shuffled[:i] {
+			if circleContains(center, radius, q) {
+				continue
+			}
+
+			center, radius = circleFrom2(p, q)
+
+			for _, r := range shuffled[:j] {
+				if circleContains(center, radius, r) {
+					continue
+				}
+
+				center, radius = circleFrom3(p, q, r)
+			}
+		}
+	}
+
+	return center, radius
+}
+
+// circleContains checks if a point is inside a circle, allowing for a small relative rounding error.
+func circleContains(center Vector2, radius float64, point Vector2) bool {
+	return point.DistanceSquared(center) <= radius*radius*(1+1e-12)
+}
+
+// circleFrom2 returns the smallest circle through two points, which has them on opposite sides.
+func circleFrom2(a, b Vector2) (Vector2, float64) {
+	center := a.Added(b)
+	center.Scale(0.5)
+
+	return center, a.Distance(b) / 2
+}
+
+// circleFrom3 returns the smallest circle with three points on or inside its boundary.
+// This is the circumcircle, unless the points are collinear,
+// in which case it is the circle through the two outermost points.
+func circleFrom3(a, b, c Vector2) (Vector2, float64) {
+	ab := b.Subbed(a)
+	ac := c.Subbed(a)
+	denominator := 2 * ab.Cross(ac)
+
+	if denominator == 0 {
+		center, radius := circleFrom2(a, b)
+
+		for _, pair := range [][2]Vector2{{a, c}, {b, c}} {
+			pairCenter, pairRadius := circleFrom2(pair[0], pair[1])
+
+			if pairRadius > radius {
+				center, radius = pairCenter, pairRadius
+			}
+		}
+
+		return center, radius
+	}
+
+	abSquared := ab.MagnitudeSquared()
+	acSquared := ac.MagnitudeSquared()
+
+	offset := Vector2{
+		X: (ac.Y*abSquared - ab.Y*acSquared) / denominator,
+		Y: (ab.X*acSquared - ac.X*abSquared) / denominator,
+	}
+
+	return a.Added(offset), offset.Magnitude()
+}
@@ -0,0 +1,113 @@
+package vectors
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestMinEnclosingCircle(t *testing.T) {
+	tests := []struct {
+		name       string
+		points     []Vector2
+		wantCenter Vector2
+		wantRadius float64
+	}{
+		{"empty", nil, Vector2{}, 0},
+		{"single point", []Vector2{{X: 2, Y: 3}}, Vector2{X: 2, Y: 3}, 0},
+		{"two points", []Vector2{{X: 0, Y: 0}, {X: 4, Y: 0}}, Vector2{X: 2, Y: 0}, 2},
+		{"duplicates", []Vector2{{X: 1, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 1}}, Vector2{X: 1, Y: 1}, 0},
+		{"collinear", []Vector2{{X: 0, Y: 0}, {X: 3, Y: 3}, {X: 1, Y: 1}, {X: -1, Y: -1}}, Vector2{X: 1, Y: 1}, 2 * math.Sqrt2},
+		{"right triangle", []Vector2{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 0, Y: 3}}, Vector2{X: 2, Y: 1.5}, 2.5},
+		{"obtuse triangle", []Vector2{{X: -2, Y: 0}, {X: 2, Y: 0}, {X: 0, Y: 0.5}}, Vector2{X: 0, Y: 0}, 2},
+		{"square with interior point", []Vector2{{X: -1, Y: -1}, {X: 1, Y: -1}, {X: 1, Y: 1}, {X: -1, Y: 1}, {X: 0, Y: 0.5}}, Vector2{}, math.Sqrt2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			center, radius := MinEnclosingCircle(tt.points)
+
+			if !center.ApproxEqual(tt.wantCenter, testEpsilon) || !approxEqual(radius, tt.wantRadius, testEpsilon) {
+				t.Errorf("MinEnclosingCircle(%v) = (%v, %v), want (%v, %v)", tt.points, center, radius, tt.wantCenter, tt.wantRadius)
+			}
+		})
+	}
+}
+
+func TestMinEnclosingCircleMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+
+	for range 200 {
+		points := make([]Vector2, 2+rng.IntN(9))
+
+		for i := range points {
+			points[i] = RandomVector2(rng, -10, 10)
+		}
+
+		center, radius := MinEnclosingCircle(points)
+
+		for _, point := range points {
+			if point.Distance(center) > radius+1e-9 {
+				t.Fatalf("MinEnclosingCircle(%v) = (%v, %v), which leaves out %v", points, center, radius, point)
+			}
+		}
+
+		if want := bruteForceEnclosingRadius(points); !approxEqual(radius, want, 1e-9) {
+			t.Errorf("MinEnclosingCircle(%v) has radius %v, want %v", points, radius, want)
+		}
+	}
+}
+
+// bruteForceEnclosingRadius returns the radius of the smallest circle containing all points,
+// by checking every circle through two or three of the points.
+func bruteForceEnclosingRadius(points []Vector2) float64 {
+	best := math.Inf(1)
+
+	consider := func(center Vector2, radius float64) {
+		for _, point := range points {
+			if point.Distance(center) > radius+1e-9 {
+				return
+			}
+		}
+
+		best = math.Min(best, radius)
+	}
+
+	for i, a := range points {
+		for j, b := range points[i+1:] {
+			consider(a.Added(b).Scaled(0.5), a.Distance(b)/2)
+
+			for _, c := range points[i+j+2:] {
+				center, ok := circumcenter(a, b, c)
+
+				if ok {
+					consider(center, center.Distance(a))
+				}
+			}
+		}
+	}
+
+	return best
+}
+
+// circumcenter returns the point that is equally far from a, b, and c,
+// found as the intersection of the perpendicular bisectors of ab and ac.
+// It returns false if the points are collinear.
+func circumcenter(a, b, c Vector2) (Vector2, bool) {
+	// Each bisector is the set of points p with 2(b-a)·p = |b|²-|a|².
+	a1, b1 := 2*(b.X-a.X), 2*(b.Y-a.Y)
+	c1 := b.MagnitudeSquared() - a.MagnitudeSquared()
+	a2, b2 := 2*(c.X-a.X), 2*(c.Y-a.Y)
+	c2 := c.MagnitudeSquared() - a.MagnitudeSquared()
+
+	determinant := a1*b2 - a2*b1
+
+	if math.Abs(determinant) < 1e-12 {
+		return Vector2{}, false
+	}
+
+	return Vector2{
+		X: (c1*b2 - c2*b1) / determinant,
+		Y: (a1*c2 - a2*c1) / determinant,
+	}, true
+}
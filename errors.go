@@ -0,0 +1,14 @@
+package vectors
+
+import (
+	"errors"
+)
+
+// ErrDivisionByZero is returned when dividing by a vector with a zero axis.
+var ErrDivisionByZero = errors.New("vectors: division by zero")
+
+// ErrLengthMismatch is returned when slices that should be paired up have different lengths.
+var ErrLengthMismatch = errors.New("vectors: slice length mismatch")
+
+// ErrZeroTotalWeight is returned when weights sum to zero, including when there are no weights at all.
+var ErrZeroTotalWeight = errors.New("vectors: total weight is zero")
@@ -0,0 +1,174 @@
+package vectors
+
+import (
+	"math"
+)
+
+const (
+	// gjkMaxIterations limits the number of refinement steps of GJKDistance2D.
+	gjkMaxIterations = 64
+	// gjkTolerance is the relative tolerance at which GJKDistance2D stops refining the distance.
+	gjkTolerance = 1e-12
+)
+
+// GJKDistance2D returns the shortest distance between the convex hulls of two shapes,
+// using the Gilbert-Johnson-Keerthi algorithm.
+// The shapes are given as point sets, such as the vertices of convex polygons, in any order.
+// It returns 0 if the shapes overlap or touch, and +Inf if either shape is empty.
+func GJKDistance2D(shapeA, shapeB []Vector2) float64 {
+	if len(shapeA) == 0 || len(shapeB) == 0 {
+		return math.Inf(1)
+	}
+
+	// The distance between the shapes equals the distance from the origin
+	// to their Minkowski difference, which is sampled through its support function.
+	support := func(direction Vector2) Vector2 {
+		return gjkSupport(shapeA, direction).Subbed(gjkSupport(shapeB, direction.Scaled(-1)))
+	}
+
+	closest := support(Vector2{X: 1, Y: 0})
+	simplex := []Vector2{closest}
+
+	for range gjkMaxIterations {
+		distanceSquared := closest.MagnitudeSquared()
+
+		if distanceSquared == 0 {
+			return 0
+		}
+
+		point := support(closest.Scaled(-1))
+
+		if distanceSquared-closest.Dot(point) <= gjkTolerance*distanceSquared {
+			break
+		}
+
+		simplex = append(simplex, point)
+		closest, simplex = gjkClosestOnSimplex(simplex)
+
+		if len(simplex) == 3 {
+			return 0
+		}
+	}
+
+	return closest.Magnitude()
+}
+
+// gjkSupport returns the point of a shape that is furthest in a direction.
+func gjkSupport(shape []Vector2, direction Vector2) Vector2 {
+	best := shape[0]
+	bestDot := best.Dot(direction)
+
+	for _, point := range shape[1:] {
+		dot := point.Dot(direction)
+
+		if dot > bestDot {
+			best = point
+			bestDot = dot
+		}
+	}
+
+	return best
+}
+
+// gjkClosestOnSimplex returns the point of a simplex of up to 3 points that is closest to the origin,
+// along with the smallest sub-simplex that still contains that point.
+// If the returned simplex still has 3 points, the origin is inside the triangle.
+func gjkClosestOnSimplex(simplex []Vector2) (Vector2, []Vector2) {
+	switch len(simplex) {
+	case 1:
+		return simplex[0], simplex
+	case 2:
+		return gjkClosestOnSegment(simplex[0], simplex[1])
+	}
+
+	return gjkClosestOnTriangle(simplex[0], simplex[1], simplex[2])
+}
+
+// gjkClosestOnSegment returns the point of the segment from a to b that is closest to the origin,
+// along with the vertices of the feature it lies on.
+func gjkClosestOnSegment(a, b Vector2) (Vector2, []Vector2) {
+	ab := b.Subbed(a)
+	lengthSquared := ab.MagnitudeSquared()
+
+	if lengthSquared == 0 {
+		return a, []Vector2{a}
+	}
+
+	t := -a.Dot(ab) / lengthSquared
+
+	if t <= 0 {
+		return a, []Vector2{a}
+	}
+
+	if t >= 1 {
+		return b, []Vector2{b}
+	}
+
+	return a.Added(ab.Scaled(t)), []Vector2{a, b}
+}
+
+// gjkClosestOnTriangle returns the point of the triangle abc that is closest to the origin,
+// along with the vertices of the feature it lies on, by checking its Voronoi regions.
+func gjkClosestOnTriangle(a, b, c Vector2) (Vector2, []Vector2) {
+	ab := b.Subbed(a)
+	ac := c.Subbed(a)
+
+	d1 := -ab.Dot(a)
+	d2 := -ac.Dot(a)
+
+	if d1 <= 0 && d2 <= 0 {
+		return a, []Vector2{a}
+	}
+
+	d3 := -ab.Dot(b)
+	d4 := -ac.Dot(b)
+
+	if d3 >= 0 && d4 <= d3 {
+		return b, []Vector2{b}
+	}
+
+	vc := d1*d4 - d3*d2
+
+	if vc <= 0 && d1 >= 0 && d3 <= 0 {
+		return a.Added(ab.Scaled(d1 / (d1 - d3))), []Vector2{a, b}
+	}
+
+	d5 := -ab.Dot(c)
+	d6 := -ac.Dot(c)
+
+	if d6 >= 0 && d5 <= d6 {
+		return c, []Vector2{c}
+	}
+
+	vb := d5*d2 - d1*d6
+
+	if vb <= 0 && d2 >= 0 && d6 <= 0 {
+		return a.Added(ac.Scaled(d2 / (d2 - d6))), []Vector2{a, c}
+	}
+
+	va := d3*d6 - d5*d4
+
+	if va <= 0 && d4-d3 >= 0 && d5-d6 >= 0 {
+		bc := c.Subbed(b)
+
+		return b.Added(bc.Scaled((d4 - d3) / ((d4 - d3) + (d5 - d6)))), []Vector2{b, c}
+	}
+
+	// A collinear triangle can only end up here through rounding errors,
+	// in which case the closest of its edges is used instead.
+	if ab.Cross(ac) == 0 {
+		best, bestSimplex := gjkClosestOnSegment(a, b)
+
+		for _, edge := range [][2]Vector2{{b, c}, {a, c}} {
+			point, edgeSimplex := gjkClosestOnSegment(edge[0], edge[1])
+
+			if point.MagnitudeSquared() < best.MagnitudeSquared() {
+				best, bestSimplex = point, edgeSimplex
+			}
+		}
+
+		return best, bestSimplex
+	}
+
+	return Vector2{}, []Vector2{a, b, c}
+}
@@ -0,0 +1,148 @@
+package vectors
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestGJKDistance2D(t *testing.T) {
+	square := []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}
+
+	tests := []struct {
+		name   string
+		shapeA []Vector2
+		shapeB []Vector2
+		want   float64
+	}{
+		{"separated on X", square, []Vector2{{X: 3, Y: 0}, {X: 4, Y: 0}, {X: 4, Y: 1}, {X: 3, Y: 1}}, 2},
+		{"separated diagonally", square, []Vector2{{X: 4, Y: 5}, {X: 5, Y: 5}, {X: 5, Y: 6}, {X: 4, Y: 6}}, 5},
+		{"vertex to edge", square, []Vector2{{X: 2, Y: 0.5}, {X: 3, Y: 0}, {X: 3, Y: 1}}, 1},
+		{"overlapping", square, []Vector2{{X: 0.5, Y: 0.5}, {X: 2, Y: 0.5}, {X: 2, Y: 2}}, 0},
+		{"touching", square, []Vector2{{X: 1, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 1, Y: 1}}, 0},
+		{"enclosed", square, []Vector2{{X: 0.25, Y: 0.25}, {X: 0.75, Y: 0.25}, {X: 0.5, Y: 0.75}}, 0},
+		{"unordered vertices", []Vector2{{X: 1, Y: 1}, {X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}}, []Vector2{{X: -3, Y: 0.5}}, 3},
+		{"single point outside", square, []Vector2{{X: 2, Y: 2}}, math.Sqrt2},
+		{"single point inside", square, []Vector2{{X: 0.5, Y: 0.5}}, 0},
+		{"two single points", []Vector2{{X: 1, Y: 2}}, []Vector2{{X: 4, Y: 6}}, 5},
+		{"same single point", []Vector2{{X: 1, Y: 2}}, []Vector2{{X: 1, Y: 2}}, 0},
+		{"segment and point", []Vector2{{X: -1, Y: 0}, {X: 1, Y: 0}}, []Vector2{{X: 0, Y: 3}}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GJKDistance2D(tt.shapeA, tt.shapeB); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("GJKDistance2D(%v, %v) = %v, want %v", tt.shapeA, tt.shapeB, got, tt.want)
+			}
+
+			if got := GJKDistance2D(tt.shapeB, tt.shapeA); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("GJKDistance2D(%v, %v) = %v, want %v", tt.shapeB, tt.shapeA, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGJKDistance2DEmpty(t *testing.T) {
+	if got := GJKDistance2D(nil, []Vector2{{X: 1, Y: 1}}); !math.IsInf(got, 1) {
+		t.Errorf("GJKDistance2D() with an empty shape = %v, want +Inf", got)
+	}
+
+	if got := GJKDistance2D([]Vector2{{X: 1, Y: 1}}, []Vector2{}); !math.IsInf(got, 1) {
+		t.Errorf("GJKDistance2D() with an empty shape = %v, want +Inf", got)
+	}
+}
+
+func TestGJKDistance2DMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+
+	randomShape := func() []Vector2 {
+		center := RandomVector2(rng, -5, 5)
+		shape := make([]Vector2, 1+rng.IntN(8))
+
+		for i := range shape {
+			shape[i] = center.Added(RandomVector2(rng, -2, 2))
+		}
+
+		return shape
+	}
+
+	for range 500 {
+		shapeA := randomShape()
+		shapeB := randomShape()
+
+		got := GJKDistance2D(shapeA, shapeB)
+		want := bruteForceHullDistance(shapeA, shapeB)
+
+		if !approxEqual(got, want, 1e-6) {
+			t.Errorf("GJKDistance2D(%v, %v) = %v, want %v", shapeA, shapeB, got, want)
+		}
+	}
+}
+
+// bruteForceHullDistance returns the distance between the convex hulls of two point sets,
+// by comparing every pair of hull edges.
+func bruteForceHullDistance(shapeA, shapeB []Vector2) float64 {
+	hullA := ConvexHull(shapeA)
+	hullB := ConvexHull(shapeB)
+
+	if hullContainsPoint(hullA, hullB[0]) || hullContainsPoint(hullB, hullA[0]) {
+		return 0
+	}
+
+	best := math.Inf(1)
+
+	for _, edgeA := range hullEdges(hullA) {
+		for _, edgeB := range hullEdges(hullB) {
+			best = math.Min(best, segmentDistance(edgeA[0], edgeA[1], edgeB[0], edgeB[1]))
+		}
+	}
+
+	return best
+}
+
+// hullEdges returns the edges of a counterclockwise hull, treating a single point as a zero-length edge.
+func hullEdges(hull []Vector2) [][2]Vector2 {
+	if len(hull) == 1 {
+		return [][2]Vector2{{hull[0], hull[0]}}
+	}
+
+	edges := make([][2]Vector2, len(hull))
+
+	for i, point := range hull {
+		edges[i] = [2]Vector2{point, hull[(i+1)%len(hull)]}
+	}
+
+	return edges
+}
+
+// hullContainsPoint checks if a point is inside or on a counterclockwise hull with at least 3 vertices.
+func hullContainsPoint(hull []Vector2, point Vector2) bool {
+	if len(hull) < 3 {
+		return false
+	}
+
+	for _, edge := range hullEdges(hull) {
+		if edge[1].Subbed(edge[0]).Cross(point.Subbed(edge[0])) < 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// segmentDistance returns the shortest distance between the segments from a to b and from c to d.
+func segmentDistance(a, b, c, d Vector2) float64 {
+	ab := b.Subbed(a)
+	cd := d.Subbed(c)
+
+	if ab.Cross(c.Subbed(a))*ab.Cross(d.Subbed(a)) < 0 && cd.Cross(a.Subbed(c))*cd.Cross(b.Subbed(c)) < 0 {
+		return 0
+	}
+
+	return min(
+		a.DistanceToSegment(c, d),
+		b.DistanceToSegment(c, d),
+		c.DistanceToSegment(a, b),
+		d.DistanceToSegment(a, b),
+	)
+}
@@ -0,0 +1,37 @@
+package vectors
+
+// IntegratePosition2D advances a position by a velocity over a time step, using explicit Euler integration.
+func IntegratePosition2D(position, velocity *Vector2, deltaTime float64) {
+	position.Add(velocity.Scaled(deltaTime))
+}
+
+// IntegrateVelocity2D advances a velocity by an acceleration over a time step, using explicit Euler integration.
+func IntegrateVelocity2D(velocity, acceleration *Vector2, deltaTime float64) {
+	velocity.Add(acceleration.Scaled(deltaTime))
+}
+
+// VerletPosition2D advances a position by a velocity and an acceleration over a time step,
+// using the second-order update x += v*dt + a*dt²/2.
+// The velocity is not changed, so it should be updated separately, such as with IntegrateVelocity2D.
+func VerletPosition2D(position, velocity, acceleration *Vector2, deltaTime float64) {
+	position.Add(velocity.Scaled(deltaTime))
+	position.Add(acceleration.Scaled(0.5 * deltaTime * deltaTime))
+}
+
+// IntegratePosition3D advances a position by a velocity over a time step, using explicit Euler integration.
+func IntegratePosition3D(position, velocity *Vector3, deltaTime float64) {
+	position.Add(velocity.Scaled(deltaTime))
+}
+
+// IntegrateVelocity3D advances a velocity by an acceleration over a time step, using explicit Euler integration.
+func IntegrateVelocity3D(velocity, acceleration *Vector3, deltaTime float64) {
+	velocity.Add(acceleration.Scaled(deltaTime))
+}
+
+// VerletPosition3D advances a position by a velocity and an acceleration over a time step,
+// using the second-order update x += v*dt + a*dt²/2.
+// The velocity is not changed, so it should be updated separately, such as with IntegrateVelocity3D.
+func VerletPosition3D(position, velocity, acceleration *Vector3, deltaTime float64) {
+	position.Add(velocity.Scaled(deltaTime))
+	position.Add(acceleration.Scaled(0.5 * deltaTime * deltaTime))
+}
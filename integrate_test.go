@@ -0,0 +1,90 @@
+package vectors
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegrate2D(t *testing.T) {
+	position := Vector2{X: 1, Y: 2}
+	velocity := Vector2{X: 3, Y: -1}
+	acceleration := Vector2{X: 0, Y: -10}
+
+	verlet := position
+	VerletPosition2D(&verlet, &velocity, &acceleration, 0.5)
+
+	if want := (Vector2{X: 2.5, Y: 0.25}); !verlet.ApproxEqual(want, testEpsilon) {
+		t.Errorf("VerletPosition2D() = %v, want %v", verlet, want)
+	}
+
+	IntegratePosition2D(&position, &velocity, 0.5)
+
+	if want := (Vector2{X: 2.5, Y: 1.5}); !position.ApproxEqual(want, testEpsilon) {
+		t.Errorf("IntegratePosition2D() = %v, want %v", position, want)
+	}
+
+	IntegrateVelocity2D(&velocity, &acceleration, 0.5)
+
+	if want := (Vector2{X: 3, Y: -6}); !velocity.ApproxEqual(want, testEpsilon) {
+		t.Errorf("IntegrateVelocity2D() = %v, want %v", velocity, want)
+	}
+}
+
+func TestIntegrate3D(t *testing.T) {
+	position := Vector3{X: 1, Y: 2, Z: 3}
+	velocity := Vector3{X: 3, Y: -1, Z: 0}
+	acceleration := Vector3{X: 0, Y: -10, Z: 2}
+
+	verlet := position
+	VerletPosition3D(&verlet, &velocity, &acceleration, 0.5)
+
+	if want := (Vector3{X: 2.5, Y: 0.25, Z: 3.25}); !verlet.ApproxEqual(want, testEpsilon) {
+		t.Errorf("VerletPosition3D() = %v, want %v", verlet, want)
+	}
+
+	IntegratePosition3D(&position, &velocity, 0.5)
+
+	if want := (Vector3{X: 2.5, Y: 1.5, Z: 3}); !position.ApproxEqual(want, testEpsilon) {
+		t.Errorf("IntegratePosition3D() = %v, want %v", position, want)
+	}
+
+	IntegrateVelocity3D(&velocity, &acceleration, 0.5)
+
+	if want := (Vector3{X: 3, Y: -6, Z: 1}); !velocity.ApproxEqual(want, testEpsilon) {
+		t.Errorf("IntegrateVelocity3D() = %v, want %v", velocity, want)
+	}
+}
+
+func TestVerletHarmonicOscillatorEnergy(t *testing.T) {
+	// A unit mass on a unit spring has an acceleration of -x and a total energy of (|v|² + |x|²) / 2.
+	const deltaTime = 0.01
+
+	position := Vector2{X: 1, Y: 0}
+	velocity := Vector2{X: 0, Y: 0.5}
+	acceleration := position.Scaled(-1)
+
+	energy := func() float64 {
+		return (velocity.MagnitudeSquared() + position.MagnitudeSquared()) / 2
+	}
+
+	initialEnergy := energy()
+	steps := int(math.Round(10 * 2 * math.Pi / deltaTime))
+
+	for range steps {
+		VerletPosition2D(&position, &velocity, &acceleration, deltaTime)
+
+		nextAcceleration := position.Scaled(-1)
+		averageAcceleration := acceleration.Added(nextAcceleration).Scaled(0.5)
+		IntegrateVelocity2D(&velocity, &averageAcceleration, deltaTime)
+		acceleration = nextAcceleration
+
+		if drift := math.Abs(energy()-initialEnergy) / initialEnergy; drift > 1e-4 {
+			t.Fatalf("energy drifted by %v after integrating to %v", drift, position)
+		}
+	}
+
+	// After 10 full periods the oscillator should be back near its starting point.
+	if want := (Vector2{X: 1, Y: 0}); !position.ApproxEqual(want, 1e-2) {
+		t.Errorf("position after 10 periods = %v, want about %v", position, want)
+	}
+}
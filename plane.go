@@ -0,0 +1,77 @@
+package vectors
+
+import (
+	"math"
+)
+
+// IPlane is the interface for a plane in 3D space.
+type IPlane interface {
+	SignedDistance(point Vector3) float64
+	ClosestPoint(point Vector3) Vector3
+	Reflect(point Vector3) Vector3
+	IntersectRay(origin, dir Vector3) (Vector3, bool)
+}
+
+// Plane represents an infinite plane in 3D space.
+// It contains all points p for which Normal.Dot(p) equals Distance.
+type Plane struct {
+	// Normal is the unit vector perpendicular to the plane.
+	Normal Vector3
+	// Distance is the signed distance from the origin to the plane, along the normal.
+	Distance float64
+}
+
+var _ IPlane = Plane{}
+
+// NewPlaneFromPointNormal creates a plane that passes through a point and is perpendicular to a normal.
+// The normal is normalized first. If the normal is zero, the plane has a zero normal and distance.
+func NewPlaneFromPointNormal(point, normal Vector3) Plane {
+	normal.Normalize()
+
+	return Plane{
+		Normal:   normal,
+		Distance: normal.Dot(point),
+	}
+}
+
+// NewPlaneFrom3Points creates a plane that passes through three points.
+// The normal follows the right-hand rule for the winding order a, b, c.
+// If the points are collinear, the plane has a zero normal and distance.
+func NewPlaneFrom3Points(a, b, c Vector3) Plane {
+	return NewPlaneFromPointNormal(a, FaceNormal(a, b, c))
+}
+
+// SignedDistance returns the distance from the plane to a point.
+// The distance is positive on the side the normal points to and negative on the other side.
+func (p Plane) SignedDistance(point Vector3) float64 {
+	return p.Normal.Dot(point) - p.Distance
+}
+
+// ClosestPoint returns the point on the plane that is closest to a point.
+func (p Plane) ClosestPoint(point Vector3) Vector3 {
+	return point.Subbed(p.Normal.Scaled(p.SignedDistance(point)))
+}
+
+// Reflect returns the mirror image of a point on the other side of the plane.
+func (p Plane) Reflect(point Vector3) Vector3 {
+	return point.Subbed(p.Normal.Scaled(2 * p.SignedDistance(point)))
+}
+
+// IntersectRay returns the point where a ray from an origin in a direction hits the plane.
+// It returns false if the ray is parallel to the plane, the plane is degenerate,
+// or the plane is behind the origin.
+func (p Plane) IntersectRay(origin, dir Vector3) (Vector3, bool) {
+	denominator := p.Normal.Dot(dir)
+
+	if math.Abs(denominator) < 1e-9 {
+		return Vector3{}, false
+	}
+
+	t := -p.SignedDistance(origin) / denominator
+
+	if t < 0 {
+		return Vector3{}, false
+	}
+
+	return origin.Added(dir.Scaled(t)), true
+}
@@ -0,0 +1,96 @@
+package vectors
+
+import (
+	"testing"
+)
+
+func TestNewPlane(t *testing.T) {
+	tests := []struct {
+		name         string
+		plane        Plane
+		wantNormal   Vector3
+		wantDistance float64
+	}{
+		{"point and normal", NewPlaneFromPointNormal(Vector3{X: 1, Y: 2, Z: 3}, Vector3{Y: 5}), Vector3{Y: 1}, 2},
+		{"negative normal", NewPlaneFromPointNormal(Vector3{X: 1, Y: 2, Z: 3}, Vector3{Z: -2}), Vector3{Z: -1}, -3},
+		{"zero normal", NewPlaneFromPointNormal(Vector3{X: 1}, Vector3{}), Vector3{}, 0},
+		{"three points", NewPlaneFrom3Points(Vector3{Z: 2}, Vector3{X: 1, Z: 2}, Vector3{Y: 1, Z: 2}), Vector3{Z: 1}, 2},
+		{"collinear points", NewPlaneFrom3Points(Vector3{}, Vector3{X: 1}, Vector3{X: 2}), Vector3{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.plane.Normal.ApproxEqual(tt.wantNormal, testEpsilon) {
+				t.Errorf("Normal = %v, want %v", tt.plane.Normal, tt.wantNormal)
+			}
+
+			if !approxEqual(tt.plane.Distance, tt.wantDistance, testEpsilon) {
+				t.Errorf("Distance = %v, want %v", tt.plane.Distance, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestPlanePointQueries(t *testing.T) {
+	plane := NewPlaneFromPointNormal(Vector3{Y: 2}, Vector3{Y: 1})
+
+	tests := []struct {
+		name        string
+		point       Vector3
+		wantSigned  float64
+		wantClosest Vector3
+		wantReflect Vector3
+	}{
+		{"above", Vector3{X: 1, Y: 5, Z: -1}, 3, Vector3{X: 1, Y: 2, Z: -1}, Vector3{X: 1, Y: -1, Z: -1}},
+		{"below", Vector3{X: 0, Y: 0, Z: 4}, -2, Vector3{X: 0, Y: 2, Z: 4}, Vector3{X: 0, Y: 4, Z: 4}},
+		{"on the plane", Vector3{X: 7, Y: 2, Z: 7}, 0, Vector3{X: 7, Y: 2, Z: 7}, Vector3{X: 7, Y: 2, Z: 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := plane.SignedDistance(tt.point); !approxEqual(got, tt.wantSigned, testEpsilon) {
+				t.Errorf("SignedDistance(%v) = %v, want %v", tt.point, got, tt.wantSigned)
+			}
+
+			if got := plane.ClosestPoint(tt.point); !got.ApproxEqual(tt.wantClosest, testEpsilon) {
+				t.Errorf("ClosestPoint(%v) = %v, want %v", tt.point, got, tt.wantClosest)
+			}
+
+			if got := plane.Reflect(tt.point); !got.ApproxEqual(tt.wantReflect, testEpsilon) {
+				t.Errorf("Reflect(%v) = %v, want %v", tt.point, got, tt.wantReflect)
+			}
+		})
+	}
+}
+
+func TestPlaneIntersectRay(t *testing.T) {
+	plane := NewPlaneFromPointNormal(Vector3{Z: -5}, Vector3{Z: 1})
+
+	tests := []struct {
+		name      string
+		origin    Vector3
+		dir       Vector3
+		want      Vector3
+		wantFound bool
+	}{
+		{"straight down", Vector3{X: 1, Y: 1}, Vector3{Z: -1}, Vector3{X: 1, Y: 1, Z: -5}, true},
+		{"from below", Vector3{Z: -10}, Vector3{Z: 2}, Vector3{Z: -5}, true},
+		{"diagonal", Vector3{}, Vector3{X: 1, Z: -1}, Vector3{X: 5, Z: -5}, true},
+		{"parallel", Vector3{}, Vector3{X: 1}, Vector3{}, false},
+		{"pointing away", Vector3{}, Vector3{Z: 1}, Vector3{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := plane.IntersectRay(tt.origin, tt.dir)
+
+			if found != tt.wantFound {
+				t.Fatalf("IntersectRay(%v, %v) found = %v, want %v", tt.origin, tt.dir, found, tt.wantFound)
+			}
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("IntersectRay(%v, %v) = %v, want %v", tt.origin, tt.dir, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package vectors
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ShoelaceArea returns the signed area of a polygon, using the shoelace formula.
+// The area is positive for counterclockwise and negative for clockwise winding.
+// It returns 0 for polygons with fewer than 3 points.
+func ShoelaceArea(polygon []Vector2) float64 {
+	if len(polygon) < 3 {
+		return 0
+	}
+
+	var doubleArea float64
+
+	for i, point := range polygon {
+		doubleArea += point.Cross(polygon[(i+1)%len(polygon)])
+	}
+
+	return doubleArea / 2
+}
+
+// PolygonPerimeter returns the total length of the edges of a polygon, including the closing edge.
+// It returns 0 for polygons with fewer than 3 points.
+func PolygonPerimeter(polygon []Vector2) float64 {
+	if len(polygon) < 3 {
+		return 0
+	}
+
+	var perimeter float64
+
+	for i, point := range polygon {
+		perimeter += point.Distance(polygon[(i+1)%len(polygon)])
+	}
+
+	return perimeter
+}
+
+// PolygonCentroid returns the center of mass of the area of a polygon.
+// If the polygon has fewer than 3 points or zero area, the average of its points is returned instead.
+func PolygonCentroid(polygon []Vector2) Vector2 {
+	area := ShoelaceArea(polygon)
+
+	if area == 0 {
+		return AverageVector2(polygon)
+	}
+
+	var centroid Vector2
+
+	for i, point := range polygon {
+		next := polygon[(i+1)%len(polygon)]
+		cross := point.Cross(next)
+
+		centroid.Add(point.Added(next).Scaled(cross))
+	}
+
+	centroid.Scale(1 / (6 * area))
+
+	return centroid
+}
+
+// ConvexHull returns the vertices of the smallest convex polygon containing all points, in counterclockwise order,
+// using Andrew's monotone chain algorithm.
+// Duplicate points and points on the edges of the hull are left out.
+// If there are fewer than 3 distinct points, the distinct points are returned,
+// and if all points are collinear, only the two outermost points are returned.
+// The input slice is not modified.
+func ConvexHull(points []Vector2) []Vector2 {
+	sorted := slices.Clone(points)
+	slices.SortFunc(sorted, func(a, b Vector2) int {
+		return cmp.Or(cmp.Compare(a.X, b.X), cmp.Compare(a.Y, b.Y))
+	})
+	sorted = slices.Compact(sorted)
+
+	if len(sorted) < 3 {
+		return sorted
+	}
+
+	hull := make([]Vector2, 0, 2*len(sorted))
+
+	for _, point := range sorted {
+		hull = appendHullPoint(hull, point, 2)
+	}
+
+	lowerLength := len(hull) + 1
+
+	for i := len(sorted) - 2; i >= 0; i-- {
+		hull = appendHullPoint(hull, sorted[i], lowerLength)
+	}
+
+	return hull[:len(hull)-1]
+}
+
+// appendHullPoint appends a point to a partial hull, first removing points that would make a clockwise or straight turn.
+// Only points beyond the first minLength-1 points of the hull may be removed.
+func appendHullPoint(hull []Vector2, point Vector2, minLength int) []Vector2 {
+	for len(hull) >= minLength {
+		a := hull[len(hull)-2]
+		b := hull[len(hull)-1]
+
+		if b.Subbed(a).Cross(point.Subbed(a)) > 0 {
+			break
+		}
+
+		hull = hull[:len(hull)-1]
+	}
+
+	return append(hull, point)
+}
@@ -0,0 +1,143 @@
+package vectors
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestShoelaceArea(t *testing.T) {
+	tests := []struct {
+		name    string
+		polygon []Vector2
+		want    float64
+	}{
+		{"empty", nil, 0},
+		{"two points", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 1}}, 0},
+		{"counterclockwise square", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}, 4},
+		{"clockwise square", []Vector2{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 0}}, -4},
+		{"triangle", []Vector2{{X: 1, Y: 1}, {X: 4, Y: 1}, {X: 1, Y: 5}}, 6},
+		{"concave L shape", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 2}, {X: 0, Y: 2}}, 3},
+		{"collinear", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShoelaceArea(tt.polygon); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("ShoelaceArea(%v) = %v, want %v", tt.polygon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygonPerimeter(t *testing.T) {
+	tests := []struct {
+		name    string
+		polygon []Vector2
+		want    float64
+	}{
+		{"empty", []Vector2{}, 0},
+		{"two points", []Vector2{{X: 0, Y: 0}, {X: 3, Y: 4}}, 0},
+		{"triangle", []Vector2{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: 4}}, 12},
+		{"square", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PolygonPerimeter(tt.polygon); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("PolygonPerimeter(%v) = %v, want %v", tt.polygon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolygonCentroid(t *testing.T) {
+	tests := []struct {
+		name    string
+		polygon []Vector2
+		want    Vector2
+	}{
+		{"square", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}, Vector2{X: 1, Y: 1}},
+		{"clockwise square", []Vector2{{X: 0, Y: 0}, {X: 0, Y: 2}, {X: 2, Y: 2}, {X: 2, Y: 0}}, Vector2{X: 1, Y: 1}},
+		{"triangle", []Vector2{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: 3}}, Vector2{X: 1, Y: 1}},
+		{"L shape", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 1, Y: 1}, {X: 1, Y: 2}, {X: 0, Y: 2}}, Vector2{X: 5.0 / 6, Y: 5.0 / 6}},
+		{"uneven vertex spacing", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 1}, {X: 0, Y: 1}}, Vector2{X: 1.5, Y: 0.5}},
+		{"degenerate", []Vector2{{X: 0, Y: 0}, {X: 2, Y: 2}}, Vector2{X: 1, Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PolygonCentroid(tt.polygon); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("PolygonCentroid(%v) = %v, want %v", tt.polygon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvexHull(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Vector2
+		want   []Vector2
+	}{
+		{"empty", nil, nil},
+		{"single point", []Vector2{{X: 1, Y: 1}}, []Vector2{{X: 1, Y: 1}}},
+		{"square with interior point", []Vector2{{X: 1, Y: 1}, {X: 0, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 0}, {X: 0, Y: 2}}, []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}},
+		{"points on edges", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 1}, {X: 2, Y: 2}, {X: 0, Y: 2}, {X: 0, Y: 1}}, []Vector2{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 2}}},
+		{"collinear", []Vector2{{X: 1, Y: 1}, {X: 3, Y: 3}, {X: 0, Y: 0}, {X: 2, Y: 2}}, []Vector2{{X: 0, Y: 0}, {X: 3, Y: 3}}},
+		{"duplicates", []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 0}}, []Vector2{{X: 0, Y: 0}, {X: 1, Y: 0}, {X: 0, Y: 1}}},
+		{"all duplicates", []Vector2{{X: 2, Y: 3}, {X: 2, Y: 3}, {X: 2, Y: 3}}, []Vector2{{X: 2, Y: 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := slices.Clone(tt.points)
+			got := ConvexHull(tt.points)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ConvexHull(%v) = %v, want %v", tt.points, got, tt.want)
+			}
+
+			if !slices.Equal(tt.points, input) {
+				t.Errorf("ConvexHull() modified its input to %v", tt.points)
+			}
+		})
+	}
+}
+
+func TestConvexHullRandomCloud(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	for range 50 {
+		points := make([]Vector2, 3+rng.IntN(100))
+
+		for i := range points {
+			points[i] = RandomVector2(rng, -10, 10)
+		}
+
+		hull := ConvexHull(points)
+
+		if len(hull) < 3 {
+			t.Fatalf("ConvexHull() of %d random points returned %d vertices", len(points), len(hull))
+		}
+
+		for i, a := range hull {
+			b := hull[(i+1)%len(hull)]
+			c := hull[(i+2)%len(hull)]
+
+			if b.Subbed(a).Cross(c.Subbed(b)) <= 0 {
+				t.Errorf("ConvexHull() does not turn counterclockwise at %v", b)
+			}
+
+			if !slices.Contains(points, a) {
+				t.Errorf("ConvexHull() returned %v, which is not an input point", a)
+			}
+
+			for _, point := range points {
+				if b.Subbed(a).Cross(point.Subbed(a)) < -testEpsilon {
+					t.Fatalf("ConvexHull() left %v outside of the edge from %v to %v", point, a, b)
+				}
+			}
+		}
+	}
+}
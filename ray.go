@@ -0,0 +1,156 @@
+package vectors
+
+import (
+	"math"
+)
+
+// IRay2D is the interface for a ray in 2D space.
+type IRay2D interface {
+	PointAt(t float64) Vector2
+	IntersectSegment(a, b Vector2) (float64, bool)
+}
+
+// IRay3D is the interface for a ray in 3D space.
+type IRay3D interface {
+	PointAt(t float64) Vector3
+	IntersectSphere(center Vector3, radius float64) (float64, bool)
+	IntersectPlane(p Plane) (float64, bool)
+	IntersectAABB(minValue, maxValue Vector3) (float64, bool)
+}
+
+// Ray2D represents a half-line in 2D space, starting at an origin and extending in a direction.
+// The direction does not need to be normalized, but hit distances are measured in multiples of it.
+type Ray2D struct {
+	Origin    Vector2
+	Direction Vector2
+}
+
+// Ray3D represents a half-line in 3D space, starting at an origin and extending in a direction.
+// The direction does not need to be normalized, but hit distances are measured in multiples of it.
+type Ray3D struct {
+	Origin    Vector3
+	Direction Vector3
+}
+
+var (
+	_ IRay2D = Ray2D{}
+	_ IRay3D = Ray3D{}
+)
+
+// PointAt returns the point at a distance t along the ray.
+func (r Ray2D) PointAt(t float64) Vector2 {
+	return r.Origin.Added(r.Direction.Scaled(t))
+}
+
+// IntersectSegment returns the distance along the ray to where it crosses the line segment from a to b.
+// It returns false if the ray misses the segment, is parallel to it, or has a zero direction.
+func (r Ray2D) IntersectSegment(a, b Vector2) (float64, bool) {
+	segment := b.Subbed(a)
+	denominator := r.Direction.Cross(segment)
+
+	if math.Abs(denominator) < 1e-9 {
+		return 0, false
+	}
+
+	offset := a.Subbed(r.Origin)
+	t := offset.Cross(segment) / denominator
+	s := offset.Cross(r.Direction) / denominator
+
+	if t < 0 || s < 0 || s > 1 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// PointAt returns the point at a distance t along the ray.
+func (r Ray3D) PointAt(t float64) Vector3 {
+	return r.Origin.Added(r.Direction.Scaled(t))
+}
+
+// IntersectSphere returns the distance along the ray to where it first enters a sphere.
+// If the origin is inside the sphere, the distance to where the ray exits is returned.
+// It returns false if the ray misses the sphere, the sphere is behind the ray, or the ray has a zero direction.
+func (r Ray3D) IntersectSphere(center Vector3, radius float64) (float64, bool) {
+	a := r.Direction.MagnitudeSquared()
+
+	if a == 0 {
+		return 0, false
+	}
+
+	offset := r.Origin.Subbed(center)
+	b := offset.Dot(r.Direction)
+	c := offset.MagnitudeSquared() - radius*radius
+	discriminant := b*b - a*c
+
+	if discriminant < 0 {
+		return 0, false
+	}
+
+	root := math.Sqrt(discriminant)
+	t := (-b - root) / a
+
+	if t < 0 {
+		t = (-b + root) / a
+	}
+
+	if t < 0 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// IntersectPlane returns the distance along the ray to where it crosses a plane.
+// It returns false if the ray is parallel to the plane, the plane is degenerate,
+// or the plane is behind the ray.
+func (r Ray3D) IntersectPlane(p Plane) (float64, bool) {
+	denominator := p.Normal.Dot(r.Direction)
+
+	if math.Abs(denominator) < 1e-9 {
+		return 0, false
+	}
+
+	t := -p.SignedDistance(r.Origin) / denominator
+
+	if t < 0 {
+		return 0, false
+	}
+
+	return t, true
+}
+
+// IntersectAABB returns the distance along the ray to where it first enters an axis-aligned bounding box,
+// using the slab method. If the origin is inside the box, a distance of 0 is returned.
+// It returns false if the ray misses the box or the box is behind the ray.
+func (r Ray3D) IntersectAABB(minValue, maxValue Vector3) (float64, bool) {
+	tMin := 0.0
+	tMax := math.Inf(1)
+
+	origin := r.Origin.ToArray()
+	direction := r.Direction.ToArray()
+	lower := minValue.ToArray()
+	upper := maxValue.ToArray()
+
+	for i := range 3 {
+		if direction[i] == 0 {
+			if origin[i] < lower[i] || origin[i] > upper[i] {
+				return 0, false
+			}
+
+			continue
+		}
+
+		t1 := (lower[i] - origin[i]) / direction[i]
+		t2 := (upper[i] - origin[i]) / direction[i]
+
+		tMin = math.Max(tMin, math.Min(t1, t2))
+		tMax = math.Min(tMax, math.Max(t1, t2))
+
+		if tMin > tMax {
+			return 0, false
+		}
+	}
+
+	return tMin, true
+}
@@ -0,0 +1,128 @@
+package vectors
+
+import (
+	"testing"
+)
+
+func TestRayPointAt(t *testing.T) {
+	ray2 := Ray2D{Origin: Vector2{X: 1, Y: 1}, Direction: Vector2{X: 2, Y: 0}}
+
+	if got, want := ray2.PointAt(1.5), (Vector2{X: 4, Y: 1}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Ray2D.PointAt(1.5) = %v, want %v", got, want)
+	}
+
+	ray3 := Ray3D{Origin: Vector3{X: 1, Y: 1, Z: 1}, Direction: Vector3{Z: -2}}
+
+	if got, want := ray3.PointAt(2), (Vector3{X: 1, Y: 1, Z: -3}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Ray3D.PointAt(2) = %v, want %v", got, want)
+	}
+}
+
+func TestRay2DIntersectSegment(t *testing.T) {
+	ray := Ray2D{Origin: Vector2{}, Direction: Vector2{X: 1, Y: 0}}
+
+	tests := []struct {
+		name      string
+		a, b      Vector2
+		want      float64
+		wantFound bool
+	}{
+		{"crossing", Vector2{X: 3, Y: -1}, Vector2{X: 3, Y: 1}, 3, true},
+		{"at an endpoint", Vector2{X: 2, Y: 0}, Vector2{X: 2, Y: 5}, 2, true},
+		{"beside the segment", Vector2{X: 3, Y: 1}, Vector2{X: 3, Y: 2}, 0, false},
+		{"behind the ray", Vector2{X: -3, Y: -1}, Vector2{X: -3, Y: 1}, 0, false},
+		{"parallel", Vector2{X: 1, Y: 1}, Vector2{X: 5, Y: 1}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := ray.IntersectSegment(tt.a, tt.b)
+
+			if found != tt.wantFound || !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("IntersectSegment(%v, %v) = (%v, %v), want (%v, %v)", tt.a, tt.b, got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestRay3DIntersectSphere(t *testing.T) {
+	tests := []struct {
+		name      string
+		ray       Ray3D
+		want      float64
+		wantFound bool
+	}{
+		{"hit from outside", Ray3D{Origin: Vector3{Z: 10}, Direction: Vector3{Z: -1}}, 8, true},
+		{"origin inside", Ray3D{Origin: Vector3{}, Direction: Vector3{X: 1}}, 2, true},
+		{"scaled direction", Ray3D{Origin: Vector3{Z: 10}, Direction: Vector3{Z: -2}}, 4, true},
+		{"tangent", Ray3D{Origin: Vector3{X: 2, Z: 5}, Direction: Vector3{Z: -1}}, 5, true},
+		{"miss", Ray3D{Origin: Vector3{X: 3, Z: 5}, Direction: Vector3{Z: -1}}, 0, false},
+		{"behind", Ray3D{Origin: Vector3{Z: 10}, Direction: Vector3{Z: 1}}, 0, false},
+		{"zero direction", Ray3D{Origin: Vector3{Z: 10}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.ray.IntersectSphere(Vector3{}, 2)
+
+			if found != tt.wantFound || !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("IntersectSphere() = (%v, %v), want (%v, %v)", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestRay3DIntersectPlane(t *testing.T) {
+	plane := NewPlaneFromPointNormal(Vector3{Y: 1}, Vector3{Y: 1})
+
+	tests := []struct {
+		name      string
+		ray       Ray3D
+		want      float64
+		wantFound bool
+	}{
+		{"from above", Ray3D{Origin: Vector3{Y: 5}, Direction: Vector3{Y: -2}}, 2, true},
+		{"from below", Ray3D{Origin: Vector3{Y: -1}, Direction: Vector3{Y: 1}}, 2, true},
+		{"parallel", Ray3D{Origin: Vector3{Y: 5}, Direction: Vector3{X: 1}}, 0, false},
+		{"pointing away", Ray3D{Origin: Vector3{Y: 5}, Direction: Vector3{Y: 1}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.ray.IntersectPlane(plane)
+
+			if found != tt.wantFound || !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("IntersectPlane() = (%v, %v), want (%v, %v)", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
+
+func TestRay3DIntersectAABB(t *testing.T) {
+	minValue := Vector3{X: -1, Y: -1, Z: -1}
+	maxValue := Vector3{X: 1, Y: 1, Z: 1}
+
+	tests := []struct {
+		name      string
+		ray       Ray3D
+		want      float64
+		wantFound bool
+	}{
+		{"hit a face", Ray3D{Origin: Vector3{X: -5}, Direction: Vector3{X: 1}}, 4, true},
+		{"hit diagonally", Ray3D{Origin: Vector3{X: -3, Y: -3, Z: 0}, Direction: Vector3{X: 1, Y: 1}}, 2, true},
+		{"origin inside", Ray3D{Origin: Vector3{}, Direction: Vector3{Z: 1}}, 0, true},
+		{"axis parallel miss", Ray3D{Origin: Vector3{X: -5, Y: 2}, Direction: Vector3{X: 1}}, 0, false},
+		{"diagonal miss", Ray3D{Origin: Vector3{X: -5, Y: 0}, Direction: Vector3{X: 1, Y: 1}}, 0, false},
+		{"behind", Ray3D{Origin: Vector3{X: 5}, Direction: Vector3{X: 1}}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := tt.ray.IntersectAABB(minValue, maxValue)
+
+			if found != tt.wantFound || !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("IntersectAABB() = (%v, %v), want (%v, %v)", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package vectors
+
+import (
+	"cmp"
+	"slices"
+)
+
+// lessToCompare turns a less function into a comparison function for the slices package.
+func lessToCompare[V any](less func(a, b V) bool) func(a, b V) int {
+	return func(a, b V) int {
+		if less(a, b) {
+			return -1
+		}
+
+		if less(b, a) {
+			return 1
+		}
+
+		return 0
+	}
+}
+
+// SortByMagnitude2 sorts the vectors in place by ascending magnitude.
+func SortByMagnitude2(vecs []Vector2) {
+	slices.SortFunc(vecs, func(a, b Vector2) int {
+		return cmp.Compare(a.MagnitudeSquared(), b.MagnitudeSquared())
+	})
+}
+
+// SortByAngle2 sorts the vectors in place by ascending angle, as returned by AngleRadians.
+// Angles are in the range [-π, π], so vectors are ordered counterclockwise starting from the negative X axis.
+func SortByAngle2(vecs []Vector2) {
+	slices.SortFunc(vecs, func(a, b Vector2) int {
+		return cmp.Compare(a.AngleRadians(), b.AngleRadians())
+	})
+}
+
+// SortVectors2 sorts the vectors in place, using less to decide whether a should come before b.
+// The sort is not guaranteed to be stable; use SortVectorsStable2 to keep the order of equal vectors.
+func SortVectors2(vecs []Vector2, less func(a, b Vector2) bool) {
+	slices.SortFunc(vecs, lessToCompare(less))
+}
+
+// SortVectorsStable2 sorts the vectors in place like SortVectors2,
+// while keeping the original order of vectors that compare as equal.
+func SortVectorsStable2(vecs []Vector2, less func(a, b Vector2) bool) {
+	slices.SortStableFunc(vecs, lessToCompare(less))
+}
+
+// SortByMagnitude3 sorts the vectors in place by ascending magnitude.
+func SortByMagnitude3(vecs []Vector3) {
+	slices.SortFunc(vecs, func(a, b Vector3) int {
+		return cmp.Compare(a.MagnitudeSquared(), b.MagnitudeSquared())
+	})
+}
+
+// SortVectors3 sorts the vectors in place, using less to decide whether a should come before b.
+// The sort is not guaranteed to be stable; use SortVectorsStable3 to keep the order of equal vectors.
+func SortVectors3(vecs []Vector3, less func(a, b Vector3) bool) {
+	slices.SortFunc(vecs, lessToCompare(less))
+}
+
+// SortVectorsStable3 sorts the vectors in place like SortVectors3,
+// while keeping the original order of vectors that compare as equal.
+func SortVectorsStable3(vecs []Vector3, less func(a, b Vector3) bool) {
+	slices.SortStableFunc(vecs, lessToCompare(less))
+}
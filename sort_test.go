@@ -0,0 +1,115 @@
+package vectors
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortByMagnitude2(t *testing.T) {
+	tests := []struct {
+		name string
+		vecs []Vector2
+		want []Vector2
+	}{
+		{"empty", []Vector2{}, []Vector2{}},
+		{"single", []Vector2{{X: 1, Y: 1}}, []Vector2{{X: 1, Y: 1}}},
+		{
+			"mixed",
+			[]Vector2{{X: 3, Y: 4}, {X: 0, Y: 0}, {X: -2, Y: 0}, {X: 0, Y: 1}},
+			[]Vector2{{X: 0, Y: 0}, {X: 0, Y: 1}, {X: -2, Y: 0}, {X: 3, Y: 4}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SortByMagnitude2(tt.vecs)
+
+			if !slices.Equal(tt.vecs, tt.want) {
+				t.Errorf("SortByMagnitude2() = %v, want %v", tt.vecs, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortByAngle2(t *testing.T) {
+	vecs := []Vector2{{X: 1, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: 1}, {X: -1, Y: -0.001}, {X: -1, Y: 1}}
+	want := []Vector2{{X: -1, Y: -0.001}, {X: 0, Y: -1}, {X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 1}}
+
+	SortByAngle2(vecs)
+
+	if !slices.Equal(vecs, want) {
+		t.Errorf("SortByAngle2() = %v, want %v", vecs, want)
+	}
+}
+
+func TestSortVectors2(t *testing.T) {
+	vecs := []Vector2{{X: 3, Y: 0}, {X: 1, Y: 5}, {X: 2, Y: -1}, {X: 0, Y: 2}}
+	want := []Vector2{{X: 0, Y: 2}, {X: 1, Y: 5}, {X: 2, Y: -1}, {X: 3, Y: 0}}
+
+	SortVectors2(vecs, func(a, b Vector2) bool { return a.X < b.X })
+
+	if !slices.Equal(vecs, want) {
+		t.Errorf("SortVectors2() = %v, want %v", vecs, want)
+	}
+}
+
+func TestSortVectorsStable2(t *testing.T) {
+	// The Y values record the original order, so vectors with equal X must keep ascending Y.
+	vecs := []Vector2{{X: 2, Y: 0}, {X: 1, Y: 1}, {X: 2, Y: 2}, {X: 1, Y: 3}, {X: 0, Y: 4}, {X: 2, Y: 5}, {X: 1, Y: 6}}
+	want := []Vector2{{X: 0, Y: 4}, {X: 1, Y: 1}, {X: 1, Y: 3}, {X: 1, Y: 6}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 2, Y: 5}}
+
+	SortVectorsStable2(vecs, func(a, b Vector2) bool { return a.X < b.X })
+
+	if !slices.Equal(vecs, want) {
+		t.Errorf("SortVectorsStable2() = %v, want %v", vecs, want)
+	}
+}
+
+func TestSortByMagnitude3(t *testing.T) {
+	tests := []struct {
+		name string
+		vecs []Vector3
+		want []Vector3
+	}{
+		{"empty", []Vector3{}, []Vector3{}},
+		{"single", []Vector3{{X: 1, Y: 1, Z: 1}}, []Vector3{{X: 1, Y: 1, Z: 1}}},
+		{
+			"mixed",
+			[]Vector3{{X: 2, Y: 3, Z: 6}, {}, {Z: -2}, {Y: 1}},
+			[]Vector3{{}, {Y: 1}, {Z: -2}, {X: 2, Y: 3, Z: 6}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SortByMagnitude3(tt.vecs)
+
+			if !slices.Equal(tt.vecs, tt.want) {
+				t.Errorf("SortByMagnitude3() = %v, want %v", tt.vecs, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortVectors3(t *testing.T) {
+	vecs := []Vector3{{Z: 3}, {X: 9, Z: 1}, {Y: -4, Z: 2}, {Z: 0}}
+	want := []Vector3{{Z: 0}, {X: 9, Z: 1}, {Y: -4, Z: 2}, {Z: 3}}
+
+	SortVectors3(vecs, func(a, b Vector3) bool { return a.Z < b.Z })
+
+	if !slices.Equal(vecs, want) {
+		t.Errorf("SortVectors3() = %v, want %v", vecs, want)
+	}
+}
+
+func TestSortVectorsStable3(t *testing.T) {
+	// The Y values record the original order, so vectors with equal Z must keep ascending Y.
+	vecs := []Vector3{{Y: 0, Z: 1}, {Y: 1, Z: 0}, {Y: 2, Z: 1}, {Y: 3, Z: 0}, {Y: 4, Z: 1}, {Y: 5, Z: -1}}
+	want := []Vector3{{Y: 5, Z: -1}, {Y: 1, Z: 0}, {Y: 3, Z: 0}, {Y: 0, Z: 1}, {Y: 2, Z: 1}, {Y: 4, Z: 1}}
+
+	SortVectorsStable3(vecs, func(a, b Vector3) bool { return a.Z < b.Z })
+
+	if !slices.Equal(vecs, want) {
+		t.Errorf("SortVectorsStable3() = %v, want %v", vecs, want)
+	}
+}
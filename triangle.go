@@ -0,0 +1,146 @@
+package vectors
+
+import (
+	"math"
+)
+
+// ITriangle2D is the interface for a triangle in 2D space.
+type ITriangle2D interface {
+	Area() float64
+	Centroid() Vector2
+	Contains(point Vector2) bool
+	Perimeter() float64
+	IsClockwise() bool
+	BarycentricCoords(point Vector2) (float64, float64, float64)
+}
+
+// Triangle2D represents a triangle in 2D space with vertices A, B, and C.
+type Triangle2D struct {
+	A Vector2
+	B Vector2
+	C Vector2
+}
+
+var _ ITriangle2D = Triangle2D{}
+
+// NewTriangle2D creates a new triangle from its vertices.
+func NewTriangle2D(a, b, c Vector2) Triangle2D {
+	return Triangle2D{
+		A: a,
+		B: b,
+		C: c,
+	}
+}
+
+// signedDoubleArea returns twice the signed area of the triangle.
+// It is positive for counterclockwise and negative for clockwise winding.
+func (t Triangle2D) signedDoubleArea() float64 {
+	return t.B.Subbed(t.A).Cross(t.C.Subbed(t.A))
+}
+
+// Area returns the area of the triangle, using the shoelace formula.
+func (t Triangle2D) Area() float64 {
+	return math.Abs(t.signedDoubleArea()) / 2
+}
+
+// Centroid returns the average of the three vertices.
+func (t Triangle2D) Centroid() Vector2 {
+	return AverageVector2([]Vector2{t.A, t.B, t.C})
+}
+
+// Contains checks if a point is inside the triangle, including points on its edges.
+// A degenerate triangle with zero area contains no points.
+func (t Triangle2D) Contains(point Vector2) bool {
+	u, v, w := t.BarycentricCoords(point)
+
+	if math.IsNaN(u) {
+		return false
+	}
+
+	const epsilon = 1e-9
+
+	return u >= -epsilon && v >= -epsilon && w >= -epsilon
+}
+
+// Perimeter returns the total length of the three edges.
+func (t Triangle2D) Perimeter() float64 {
+	return t.A.Distance(t.B) + t.B.Distance(t.C) + t.C.Distance(t.A)
+}
+
+// IsClockwise checks if the vertices A, B, and C are in clockwise order.
+// A degenerate triangle is not clockwise.
+func (t Triangle2D) IsClockwise() bool {
+	return t.signedDoubleArea() < 0
+}
+
+// BarycentricCoords returns the weights of the vertices A, B, and C that reconstruct a point.
+// The weights sum to 1, and are all in [0, 1] for points inside the triangle.
+// If the triangle is degenerate, all weights are NaN.
+func (t Triangle2D) BarycentricCoords(point Vector2) (float64, float64, float64) {
+	doubleArea := t.signedDoubleArea()
+
+	if doubleArea == 0 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	u := t.B.Subbed(point).Cross(t.C.Subbed(point)) / doubleArea
+	v := t.C.Subbed(point).Cross(t.A.Subbed(point)) / doubleArea
+
+	return u, v, 1 - u - v
+}
+
+// BarycentricCoords2D returns the weights of the vertices a, b, and c that reconstruct a point p.
+// The weights sum to 1, and are all in [0, 1] for points inside the triangle.
+// If the triangle is degenerate, all weights are NaN.
+func BarycentricCoords2D(p, a, b, c Vector2) (u, v, w float64) {
+	return NewTriangle2D(a, b, c).BarycentricCoords(p)
+}
+
+// BarycentricInterpolate2D interpolates values at the vertices a, b, and c to a point p,
+// using the barycentric coordinates of p.
+func BarycentricInterpolate2D(a, b, c Vector2, ua, ub, uc float64, p Vector2) float64 {
+	u, v, w := BarycentricCoords2D(p, a, b, c)
+
+	return u*ua + v*ub + w*uc
+}
+
+// BarycentricCoords3D returns the weights of the vertices a, b, and c that reconstruct a point p.
+// If p is not in the plane of the triangle, the coordinates of its projection onto that plane are returned.
+// The weights sum to 1, and are all in [0, 1] for points inside the triangle.
+// If the triangle is degenerate, all weights are NaN.
+func BarycentricCoords3D(p, a, b, c Vector3) (u, v, w float64) {
+	ab := b.Subbed(a)
+	ac := c.Subbed(a)
+	ap := p.Subbed(a)
+
+	d00 := ab.Dot(ab)
+	d01 := ab.Dot(ac)
+	d11 := ac.Dot(ac)
+	d20 := ap.Dot(ab)
+	d21 := ap.Dot(ac)
+	denominator := d00*d11 - d01*d01
+
+	if denominator == 0 {
+		return math.NaN(), math.NaN(), math.NaN()
+	}
+
+	v = (d11*d20 - d01*d21) / denominator
+	w = (d00*d21 - d01*d20) / denominator
+
+	return 1 - v - w, v, w
+}
+
+// BarycentricInterpolate3D interpolates values at the vertices a, b, and c to a point p,
+// using the barycentric coordinates of p.
+func BarycentricInterpolate3D(a, b, c Vector3, ua, ub, uc float64, p Vector3) float64 {
+	u, v, w := BarycentricCoords3D(p, a, b, c)
+
+	return u*ua + v*ub + w*uc
+}
+
+// IsCollinear2D checks if three points lie on a single line,
+// by checking if the area of the triangle they form is at most epsilon.
+// An epsilon of 0 only accepts exactly collinear points.
+func IsCollinear2D(a, b, c Vector2, epsilon float64) bool {
+	return NewTriangle2D(a, b, c).Area() <= epsilon
+}
@@ -0,0 +1,203 @@
+package vectors
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTriangle2DMeasurements(t *testing.T) {
+	tests := []struct {
+		name          string
+		triangle      Triangle2D
+		wantArea      float64
+		wantPerimeter float64
+		wantCentroid  Vector2
+		wantClockwise bool
+	}{
+		{
+			"right triangle",
+			NewTriangle2D(Vector2{X: 0, Y: 0}, Vector2{X: 3, Y: 0}, Vector2{X: 0, Y: 4}),
+			6, 12, Vector2{X: 1, Y: 4.0 / 3}, false,
+		},
+		{
+			"clockwise winding",
+			NewTriangle2D(Vector2{X: 0, Y: 0}, Vector2{X: 0, Y: 4}, Vector2{X: 3, Y: 0}),
+			6, 12, Vector2{X: 1, Y: 4.0 / 3}, true,
+		},
+		{
+			"offset",
+			NewTriangle2D(Vector2{X: 1, Y: 1}, Vector2{X: 3, Y: 1}, Vector2{X: 2, Y: 4}),
+			3, 2 + 2*Vector2{X: 1, Y: 3}.Magnitude(), Vector2{X: 2, Y: 2}, false,
+		},
+		{
+			"degenerate",
+			NewTriangle2D(Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 1}, Vector2{X: 2, Y: 2}),
+			0, 2 * Vector2{X: 2, Y: 2}.Magnitude(), Vector2{X: 1, Y: 1}, false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.triangle.Area(); !approxEqual(got, tt.wantArea, testEpsilon) {
+				t.Errorf("Area() = %v, want %v", got, tt.wantArea)
+			}
+
+			if got := tt.triangle.Perimeter(); !approxEqual(got, tt.wantPerimeter, testEpsilon) {
+				t.Errorf("Perimeter() = %v, want %v", got, tt.wantPerimeter)
+			}
+
+			if got := tt.triangle.Centroid(); !got.ApproxEqual(tt.wantCentroid, testEpsilon) {
+				t.Errorf("Centroid() = %v, want %v", got, tt.wantCentroid)
+			}
+
+			if got := tt.triangle.IsClockwise(); got != tt.wantClockwise {
+				t.Errorf("IsClockwise() = %v, want %v", got, tt.wantClockwise)
+			}
+		})
+	}
+}
+
+func TestTriangle2DContains(t *testing.T) {
+	counterclockwise := NewTriangle2D(Vector2{X: 0, Y: 0}, Vector2{X: 4, Y: 0}, Vector2{X: 0, Y: 4})
+	clockwise := NewTriangle2D(counterclockwise.A, counterclockwise.C, counterclockwise.B)
+
+	tests := []struct {
+		name  string
+		point Vector2
+		want  bool
+	}{
+		{"inside", Vector2{X: 1, Y: 1}, true},
+		{"on a vertex", Vector2{X: 4, Y: 0}, true},
+		{"on an edge", Vector2{X: 2, Y: 2}, true},
+		{"outside the hypotenuse", Vector2{X: 3, Y: 3}, false},
+		{"outside a leg", Vector2{X: -1, Y: 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := counterclockwise.Contains(tt.point); got != tt.want {
+				t.Errorf("counterclockwise Contains(%v) = %v, want %v", tt.point, got, tt.want)
+			}
+
+			if got := clockwise.Contains(tt.point); got != tt.want {
+				t.Errorf("clockwise Contains(%v) = %v, want %v", tt.point, got, tt.want)
+			}
+		})
+	}
+
+	degenerate := NewTriangle2D(Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 1}, Vector2{X: 2, Y: 2})
+
+	if degenerate.Contains(Vector2{X: 1, Y: 1}) {
+		t.Error("a degenerate triangle contains a point")
+	}
+}
+
+func TestBarycentricCoords2D(t *testing.T) {
+	a := Vector2{X: 0, Y: 0}
+	b := Vector2{X: 4, Y: 0}
+	c := Vector2{X: 0, Y: 4}
+
+	tests := []struct {
+		name    string
+		point   Vector2
+		u, v, w float64
+	}{
+		{"vertex a", a, 1, 0, 0},
+		{"vertex b", b, 0, 1, 0},
+		{"vertex c", c, 0, 0, 1},
+		{"centroid", Vector2{X: 4.0 / 3, Y: 4.0 / 3}, 1.0 / 3, 1.0 / 3, 1.0 / 3},
+		{"edge midpoint", Vector2{X: 2, Y: 2}, 0, 0.5, 0.5},
+		{"outside", Vector2{X: 4, Y: 4}, -1, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, v, w := BarycentricCoords2D(tt.point, a, b, c)
+
+			if !approxEqual(u, tt.u, testEpsilon) || !approxEqual(v, tt.v, testEpsilon) || !approxEqual(w, tt.w, testEpsilon) {
+				t.Errorf("BarycentricCoords2D(%v) = (%v, %v, %v), want (%v, %v, %v)", tt.point, u, v, w, tt.u, tt.v, tt.w)
+			}
+
+			reconstructed := a.Scaled(u).Added(b.Scaled(v)).Added(c.Scaled(w))
+
+			if !reconstructed.ApproxEqual(tt.point, testEpsilon) {
+				t.Errorf("reconstructing %v from its barycentric coordinates = %v", tt.point, reconstructed)
+			}
+
+			if got, want := BarycentricInterpolate2D(a, b, c, 10, 20, 30, tt.point), 10*tt.u+20*tt.v+30*tt.w; !approxEqual(got, want, testEpsilon) {
+				t.Errorf("BarycentricInterpolate2D(%v) = %v, want %v", tt.point, got, want)
+			}
+		})
+	}
+
+	u, v, w := BarycentricCoords2D(Vector2{X: 1, Y: 1}, a, Vector2{X: 1, Y: 1}, Vector2{X: 2, Y: 2})
+
+	if !math.IsNaN(u) || !math.IsNaN(v) || !math.IsNaN(w) {
+		t.Errorf("BarycentricCoords2D() for a degenerate triangle = (%v, %v, %v), want NaN", u, v, w)
+	}
+}
+
+func TestBarycentricCoords3D(t *testing.T) {
+	a := Vector3{X: 1, Y: 0, Z: 0}
+	b := Vector3{X: 0, Y: 1, Z: 0}
+	c := Vector3{X: 0, Y: 0, Z: 1}
+
+	tests := []struct {
+		name    string
+		point   Vector3
+		u, v, w float64
+	}{
+		{"vertex a", a, 1, 0, 0},
+		{"vertex c", c, 0, 0, 1},
+		{"centroid", Vector3{X: 1.0 / 3, Y: 1.0 / 3, Z: 1.0 / 3}, 1.0 / 3, 1.0 / 3, 1.0 / 3},
+		{"off the plane", Vector3{X: 1, Y: 1, Z: 1}, 1.0 / 3, 1.0 / 3, 1.0 / 3},
+		{"edge midpoint", Vector3{X: 0.5, Y: 0.5}, 0.5, 0.5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, v, w := BarycentricCoords3D(tt.point, a, b, c)
+
+			if !approxEqual(u, tt.u, testEpsilon) || !approxEqual(v, tt.v, testEpsilon) || !approxEqual(w, tt.w, testEpsilon) {
+				t.Errorf("BarycentricCoords3D(%v) = (%v, %v, %v), want (%v, %v, %v)", tt.point, u, v, w, tt.u, tt.v, tt.w)
+			}
+
+			if got, want := BarycentricInterpolate3D(a, b, c, 1, 2, 3, tt.point), tt.u+2*tt.v+3*tt.w; !approxEqual(got, want, testEpsilon) {
+				t.Errorf("BarycentricInterpolate3D(%v) = %v, want %v", tt.point, got, want)
+			}
+		})
+	}
+
+	u, v, w := BarycentricCoords3D(Vector3{}, a, a, c)
+
+	if !math.IsNaN(u) || !math.IsNaN(v) || !math.IsNaN(w) {
+		t.Errorf("BarycentricCoords3D() for a degenerate triangle = (%v, %v, %v), want NaN", u, v, w)
+	}
+}
+
+func TestIsCollinear2D(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, c Vector2
+		epsilon float64
+		want    bool
+	}{
+		{"on a line", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 1}, Vector2{X: 3, Y: 3}, testEpsilon, true},
+		{"out of order", Vector2{X: 3, Y: 3}, Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 1}, testEpsilon, true},
+		{"duplicate points", Vector2{X: 1, Y: 2}, Vector2{X: 1, Y: 2}, Vector2{X: 5, Y: -7}, testEpsilon, true},
+		{"exact with zero epsilon", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 0}, Vector2{X: 2, Y: 0}, 0, true},
+		{"nearly collinear", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 1e-12}, Vector2{X: 2, Y: 0}, testEpsilon, true},
+		{"nearly collinear with zero epsilon", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 1e-12}, Vector2{X: 2, Y: 0}, 0, false},
+		{"below the area threshold", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 0.1}, Vector2{X: 2, Y: 0}, 0.2, true},
+		{"above the area threshold", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 0.1}, Vector2{X: 2, Y: 0}, 0.05, false},
+		{"triangle", Vector2{X: 0, Y: 0}, Vector2{X: 1, Y: 0}, Vector2{X: 0, Y: 1}, testEpsilon, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCollinear2D(tt.a, tt.b, tt.c, tt.epsilon); got != tt.want {
+				t.Errorf("IsCollinear2D(%v, %v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, tt.epsilon, got, tt.want)
+			}
+		})
+	}
+}
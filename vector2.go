@@ -1,39 +1,185 @@
 package vectors
 
 import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
 	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
 )
 
-// IVector2 is the interface for a 2D vector.
-type IVector2 interface {
-	Add(vec Vector2)
-	Sub(vec Vector2)
-	Mul(vec Vector2)
-	Div(vec Vector2)
-	Scale(scale float64)
+// IVec2 is the interface for a 2D vector with components of type T.
+type IVec2[T Float] interface {
+	Add(vec Vec2[T])
+	Added(vec Vec2[T]) Vec2[T]
+	Sub(vec Vec2[T])
+	Subbed(vec Vec2[T]) Vec2[T]
+	Mul(vec Vec2[T])
+	Muled(vec Vec2[T]) Vec2[T]
+	Div(vec Vec2[T])
+	Dived(vec Vec2[T]) Vec2[T]
+	Scale(scale T)
+	Scaled(scale T) Vec2[T]
 	Bounce()
 	Normalize()
-	AngleRadians() float64
-	AngleDegrees() float64
+	Normalized() Vec2[T]
+	AngleRadians() T
+	AngleDegrees() T
+	AngleBetween(vec Vec2[T]) T
+	AngleBetweenDegrees(vec Vec2[T]) T
+	SignedAngleTo(vec Vec2[T]) T
+	SignedAngleToDegrees(vec Vec2[T]) T
 	IsZero() bool
-	Magnitude() float64
-	MagnitudeSquared() float64
-	Distance(vec Vector2) float64
-	DistanceSquared(vec Vector2) float64
-	Dot(vec Vector2) float64
-	Lerp(vec Vector2, t float64)
-	ClampMagnitude(maxValue float64)
+	IsNaN() bool
+	IsInf() bool
+	IsFinite() bool
+	IsNormalized() bool
+	Magnitude() T
+	MagnitudeSquared() T
+	Distance(vec Vec2[T]) T
+	DistanceSquared(vec Vec2[T]) T
+	Dot(vec Vec2[T]) T
+	Cross(vec Vec2[T]) T
+	Lerp(vec Vec2[T], t T)
+	LerpUnclamped(target Vec2[T], t T)
+	LerpClamped(target Vec2[T], t T)
+	ClampMagnitude(maxValue T)
 	Clear()
-	ToVector3() Vector3
+	Reflect(normal Vec2[T])
+	Project(onto Vec2[T])
+	Reject(from Vec2[T])
+	String() string
+	GoString() string
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+	Equal(vec Vec2[T]) bool
+	ApproxEqual(vec Vec2[T], epsilon T) bool
+	Clamp(minValue, maxValue Vec2[T])
+	ClampScalar(minValue, maxValue T)
+	Abs()
+	Absed() Vec2[T]
+	Floor()
+	Ceil()
+	Round()
+	Trunc()
+	ComponentMin(vec Vec2[T])
+	ComponentMax(vec Vec2[T])
+	Rotate(radians T)
+	RotateDegrees(degrees T)
+	RotateAround(pivot Vec2[T], radians T)
+	RotateAroundDegrees(pivot Vec2[T], degrees T)
+	Perpendicular()
+	PerpendicularVector() Vec2[T]
+	PerpendicularClockwise()
+	PerpendicularClockwiseVector() Vec2[T]
+	MoveTowards(target Vec2[T], maxDelta T)
+	SmoothStep(target Vec2[T], t T)
+	SmootherStep(target Vec2[T], t T)
+	SafeDiv(vec Vec2[T]) error
+	ClampMagnitudeRange(minValue, maxValue T)
+	SetMagnitude(magnitude T)
+	IsParallel(vec Vec2[T], epsilon T) bool
+	IsPerpendicular(vec Vec2[T], epsilon T) bool
+	Clone() Vec2[T]
+	SnapToGrid(gridSize T)
+	SnapToGridXY(gridX, gridY T)
+	ComponentSum() T
+	ComponentProduct() T
+	MaxComponent() T
+	MinComponent() T
+	MaxComponentIndex() int
+	MinComponentIndex() int
+	SafeNormalize(fallback Vec2[T])
+	MarshalText() ([]byte, error)
+	UnmarshalText(data []byte) error
+	ManhattanDistance(vec Vec2[T]) T
+	ChebyshevDistance(vec Vec2[T]) T
+	MinkowskiDistance(vec Vec2[T], p T) T
+	CosineSimilarity(vec Vec2[T]) T
+	Wrap(minValue, maxValue Vec2[T])
+	WrapScalar(minValue, maxValue T)
+	ToroidalDistance(vec, size Vec2[T]) T
+	ClosestPointOnSegment(a, b Vec2[T]) Vec2[T]
+	DistanceToSegment(a, b Vec2[T]) T
+	Get(i int) T
+	Set(i int, val T)
+	Len() int
+	Components() []T
+	Map(f func(T) T) Vec2[T]
+	Saturate()
+	Saturated() Vec2[T]
+	Fract()
+	Fracted() Vec2[T]
+	Step(edge Vec2[T]) Vec2[T]
+	SmoothStepComponent(edge0, edge1 Vec2[T]) Vec2[T]
+	Quantize(precision int)
+	QuantizeToStep(step T)
+	ReflectWithRestitution(normal Vec2[T], restitution T)
+	Hash() uint64
+	QuantizedHash(step T) uint64
+	AngleRelativeTo(reference Vec2[T]) T
+	AngleRelativeToDegrees(reference Vec2[T]) T
+	DirectionTo(target Vec2[T]) Vec2[T]
+	DirectionFrom(source Vec2[T]) Vec2[T]
+	XY() Vec2[T]
+	YX() Vec2[T]
+	ToVector3WithZ(z T) Vec3[T]
+	MirrorX()
+	MirrorY()
+	MirrorAcross(axis Vec2[T])
+	ScaleAround(pivot Vec2[T], scale T)
+	ScaleAroundNonUniform(pivot, scale Vec2[T])
+	Reciprocal()
+	RecipMul(vec Vec2[T]) Vec2[T]
+	Decompose(axis Vec2[T]) (parallel, perpendicular Vec2[T])
+	Midpoint(vec Vec2[T]) Vec2[T]
+	WeightedMidpoint(vec Vec2[T], weight T) Vec2[T]
+	IsInCircle(center Vec2[T], radius T) bool
+	IsOnCircle(center Vec2[T], radius, epsilon T) bool
+	IsInAABB(minValue, maxValue Vec2[T]) bool
+	IsInAABBExclusive(minValue, maxValue Vec2[T]) bool
+	NearestPointOnLine(linePoint, lineDir Vec2[T]) Vec2[T]
+	DistanceToLine(linePoint, lineDir Vec2[T]) T
+	ToComplex() complex128
+	PackFloat32() [8]byte
+	ToFloat32Array2() [2]float32
+	MortonCode(scale, offset T) uint64
+	ToArray() [2]T
+	ToSlice() []T
+	ToVector2i() Vector2i
+	ToVector3() Vec3[T]
+	ToGLArray() [2]float32
 }
 
-// Vector2 represents a 2D vector with X and Y coordinates.
+// IVector2 is the interface for a 2D vector with float64 components.
+type IVector2 = IVec2[float64]
+
+// Vec2 represents a 2D vector with X and Y coordinates of any float type.
 // It provides methods for vector operations.
-type Vector2 struct {
-	X float64
-	Y float64
+type Vec2[T Float] struct {
+	X T
+	Y T
 }
 
+// Vector2 is a 2D vector with float64 coordinates.
+type Vector2 = Vec2[float64]
+
+var (
+	_ IVec2[float32]             = (*Vec2[float32])(nil)
+	_ IVec2[float64]             = (*Vec2[float64])(nil)
+	_ encoding.BinaryMarshaler   = Vec2[float64]{}
+	_ encoding.BinaryUnmarshaler = (*Vec2[float64])(nil)
+	_ encoding.TextMarshaler     = Vec2[float64]{}
+	_ encoding.TextUnmarshaler   = (*Vec2[float64])(nil)
+)
+
+// NewVector2 creates a new 2D vector from its coordinates.
 func NewVector2(x, y float64) Vector2 {
 	return Vector2{
 		X: x,
@@ -41,61 +187,197 @@ func NewVector2(x, y float64) Vector2 {
 	}
 }
 
+// NewVec2 creates a new 2D vector of any float type from its coordinates.
+func NewVec2[T Float](x, y T) Vec2[T] {
+	return Vec2[T]{
+		X: x,
+		Y: y,
+	}
+}
+
+// Vec2FromVector2 converts a Vector2 to a vector of any float type, converting each component to T.
+func Vec2FromVector2[T Float](v Vector2) Vec2[T] {
+	return Vec2[T]{
+		X: T(v.X),
+		Y: T(v.Y),
+	}
+}
+
+// Vector2FromVec2 converts a vector of any float type to a Vector2.
+func Vector2FromVec2[T Float](v Vec2[T]) Vector2 {
+	return Vector2{
+		X: float64(v.X),
+		Y: float64(v.Y),
+	}
+}
+
+// Vector2Zero returns a vector with all axes set to zero.
+func Vector2Zero() Vector2 {
+	return Vector2{X: 0, Y: 0}
+}
+
+// Vector2One returns a vector with all axes set to one.
+func Vector2One() Vector2 {
+	return Vector2{X: 1, Y: 1}
+}
+
+// Vector2Up returns a unit vector pointing along the positive Y axis.
+func Vector2Up() Vector2 {
+	return Vector2{X: 0, Y: 1}
+}
+
+// Vector2Right returns a unit vector pointing along the positive X axis.
+func Vector2Right() Vector2 {
+	return Vector2{X: 1, Y: 0}
+}
+
+// Vector2FromArray creates a new 2D vector from an array of its coordinates.
+func Vector2FromArray(a [2]float64) Vector2 {
+	return Vector2{X: a[0], Y: a[1]}
+}
+
+// UnpackVector2Float32 decodes a vector from 8 bytes of little-endian IEEE 754 float32 values,
+// as produced by PackFloat32.
+func UnpackVector2Float32(b [8]byte) Vector2 {
+	return Vector2{
+		X: float64(math.Float32frombits(binary.LittleEndian.Uint32(b[0:]))),
+		Y: float64(math.Float32frombits(binary.LittleEndian.Uint32(b[4:]))),
+	}
+}
+
+// Vector2FromComplex creates a new 2D vector from a complex number,
+// using the real part as X and the imaginary part as Y.
+func Vector2FromComplex(c complex128) Vector2 {
+	return Vector2{X: real(c), Y: imag(c)}
+}
+
+// ParseVector2 parses a vector from comma-separated components, such as "(1.5, 2.3)" or "1.5,2.3".
+// Surrounding whitespace and a pair of parentheses or brackets are allowed,
+// so the output of String can be parsed back.
+func ParseVector2(s string) (Vector2, error) {
+	var v Vector2
+
+	err := v.UnmarshalText([]byte(trimVectorDelimiters(s)))
+
+	if err != nil {
+		return Vector2{}, err
+	}
+
+	return v, nil
+}
+
+// RandomVector2 creates a vector with each axis uniformly distributed in [minVal, maxVal).
+func RandomVector2(rng *rand.Rand, minVal, maxVal float64) Vector2 {
+	return Vector2{
+		X: minVal + rng.Float64()*(maxVal-minVal),
+		Y: minVal + rng.Float64()*(maxVal-minVal),
+	}
+}
+
+// RandomUnitVector2 creates a unit vector pointing in a uniformly random direction.
+func RandomUnitVector2(rng *rand.Rand) Vector2 {
+	sin, cos := math.Sincos(rng.Float64() * 2 * math.Pi)
+
+	return Vector2{X: cos, Y: sin}
+}
+
 // Add adds the values of another vector to this one.
-func (v *Vector2) Add(vec Vector2) {
+func (v *Vec2[T]) Add(vec Vec2[T]) {
 	v.X += vec.X
 	v.Y += vec.Y
 }
 
+// Added returns a copy of this vector with the values of another vector added to it.
+func (v Vec2[T]) Added(vec Vec2[T]) Vec2[T] {
+	v.Add(vec)
+
+	return v
+}
+
 // Sub subtracts the values of another vector from this one.
-func (v *Vector2) Sub(vec Vector2) {
+func (v *Vec2[T]) Sub(vec Vec2[T]) {
 	v.X -= vec.X
 	v.Y -= vec.Y
 }
 
+// Subbed returns a copy of this vector with the values of another vector subtracted from it.
+func (v Vec2[T]) Subbed(vec Vec2[T]) Vec2[T] {
+	v.Sub(vec)
+
+	return v
+}
+
 // Mul multiplies this vector by another vector.
-func (v *Vector2) Mul(vec Vector2) {
+func (v *Vec2[T]) Mul(vec Vec2[T]) {
 	v.X *= vec.X
 	v.Y *= vec.Y
 }
 
+// Muled returns a copy of this vector multiplied by another vector.
+func (v Vec2[T]) Muled(vec Vec2[T]) Vec2[T] {
+	v.Mul(vec)
+
+	return v
+}
+
 // Div divides this vector by another vector.
-func (v *Vector2) Div(vec Vector2) {
+func (v *Vec2[T]) Div(vec Vec2[T]) {
 	v.X /= vec.X
 	v.Y /= vec.Y
 }
 
+// Dived returns a copy of this vector divided by another vector.
+func (v Vec2[T]) Dived(vec Vec2[T]) Vec2[T] {
+	v.Div(vec)
+
+	return v
+}
+
 // Scale multiplies this vector by a scale.
-func (v *Vector2) Scale(scale float64) {
+func (v *Vec2[T]) Scale(scale T) {
 	v.X *= scale
 	v.Y *= scale
 }
 
+// Scaled returns a copy of this vector multiplied by a scale.
+func (v Vec2[T]) Scaled(scale T) Vec2[T] {
+	v.Scale(scale)
+
+	return v
+}
+
 // Bounce inverts the direction of the vector.
-func (v *Vector2) Bounce() {
+func (v *Vec2[T]) Bounce() {
 	v.X = -v.X
 	v.Y = -v.Y
 }
 
 // Normalize scales the vector to have a magnitude of 1.
-func (v *Vector2) Normalize() {
+func (v *Vec2[T]) Normalize() {
 	magnitudeSquared := v.X*v.X + v.Y*v.Y
 
 	if magnitudeSquared != 0 {
-		magnitude := math.Sqrt(magnitudeSquared)
+		magnitude := sqrt(magnitudeSquared)
 		v.X /= magnitude
 		v.Y /= magnitude
 	}
 }
 
+// Normalized returns a copy of this vector with a magnitude of 1.
+func (v Vec2[T]) Normalized() Vec2[T] {
+	v.Normalize()
+
+	return v
+}
+
 // AngleRadians returns the angle in radians.
-func (v Vector2) AngleRadians() float64 {
-	return math.Atan2(v.Y, v.X)
+func (v Vec2[T]) AngleRadians() T {
+	return atan2(v.Y, v.X)
 }
 
 // AngleDegrees returns the angle of the vector in degrees.
-func (v Vector2) AngleDegrees() float64 {
-	angle := math.Atan2(v.Y, v.X) * 180 / math.Pi
+func (v Vec2[T]) AngleDegrees() T {
+	angle := atan2(v.Y, v.X) * 180 / math.Pi
 
 	if angle < 0 {
 		angle += 360
@@ -104,31 +386,96 @@ func (v Vector2) AngleDegrees() float64 {
 	return angle
 }
 
+// AngleBetween returns the unsigned angle between this vector and another vector in radians.
+// The result is in the range [0, π], or 0 if either vector is zero.
+func (v Vec2[T]) AngleBetween(vec Vec2[T]) T {
+	magnitudes := v.Magnitude() * vec.Magnitude()
+
+	if magnitudes == 0 {
+		return 0
+	}
+
+	cos := v.Dot(vec) / magnitudes
+
+	return acos(max(-1, min(1, cos)))
+}
+
+// AngleBetweenDegrees returns the unsigned angle between this vector and another vector in degrees.
+// The result is in the range [0, 180], or 0 if either vector is zero.
+func (v Vec2[T]) AngleBetweenDegrees(vec Vec2[T]) T {
+	return v.AngleBetween(vec) * 180 / math.Pi
+}
+
+// SignedAngleTo returns the angle in radians to rotate this vector counterclockwise to reach another vector.
+// The result is in the range (-π, π], where negative values are clockwise.
+func (v Vec2[T]) SignedAngleTo(vec Vec2[T]) T {
+	angle := atan2(v.Cross(vec), v.Dot(vec))
+
+	if angle == -math.Pi {
+		angle = math.Pi
+	}
+
+	return angle
+}
+
+// SignedAngleToDegrees returns the angle in degrees to rotate this vector counterclockwise to reach another vector.
+// The result is in the range (-180, 180], where negative values are clockwise.
+func (v Vec2[T]) SignedAngleToDegrees(vec Vec2[T]) T {
+	return v.SignedAngleTo(vec) * 180 / math.Pi
+}
+
 // IsZero checks if all axes are zero.
-func (v Vector2) IsZero() bool {
+func (v Vec2[T]) IsZero() bool {
 	return v.X == 0 && v.Y == 0
 }
 
+// IsNaN checks if any axis is NaN.
+func (v Vec2[T]) IsNaN() bool {
+	return isNaN(v.X) || isNaN(v.Y)
+}
+
+// IsInf checks if any axis is positive or negative infinity.
+func (v Vec2[T]) IsInf() bool {
+	return isInf(v.X) || isInf(v.Y)
+}
+
+// IsFinite checks if all axes are neither NaN nor infinite.
+func (v Vec2[T]) IsFinite() bool {
+	return !v.IsNaN() && !v.IsInf()
+}
+
+// IsNormalized checks if the vector has a magnitude of 1, within NormalizationEpsilon,
+// or NormalizationEpsilon32 for float32 components.
+func (v Vec2[T]) IsNormalized() bool {
+	epsilon := NormalizationEpsilon
+
+	if bitSize[T]() == 32 {
+		epsilon = NormalizationEpsilon32
+	}
+
+	return math.Abs(float64(v.MagnitudeSquared())-1) < epsilon
+}
+
 // Magnitude returns the length of the vector.
-func (v Vector2) Magnitude() float64 {
-	return math.Sqrt((v.X * v.X) + (v.Y * v.Y))
+func (v Vec2[T]) Magnitude() T {
+	return sqrt((v.X * v.X) + (v.Y * v.Y))
 }
 
 // MagnitudeSquared returns the squared magnitude of the vector.
-func (v Vector2) MagnitudeSquared() float64 {
+func (v Vec2[T]) MagnitudeSquared() T {
 	return (v.X * v.X) + (v.Y * v.Y)
 }
 
 // Distance returns the distance between this vector and another vector.
-func (v Vector2) Distance(vec Vector2) float64 {
+func (v Vec2[T]) Distance(vec Vec2[T]) T {
 	dx := v.X - vec.X
 	dy := v.Y - vec.Y
 
-	return math.Sqrt(dx*dx + dy*dy)
+	return sqrt(dx*dx + dy*dy)
 }
 
 // DistanceSquared returns the squared distance between this vector and another vector.
-func (v Vector2) DistanceSquared(vec Vector2) float64 {
+func (v Vec2[T]) DistanceSquared(vec Vec2[T]) T {
 	dx := v.X - vec.X
 	dy := v.Y - vec.Y
 	return dx*dx + dy*dy
@@ -136,18 +483,37 @@ func (v Vector2) DistanceSquared(vec Vector2) float64 {
 
 // Dot returns the dot product.
 // Positive = same direction, negative = opposite, zero = perpendicular.
-func (v Vector2) Dot(vec Vector2) float64 {
+func (v Vec2[T]) Dot(vec Vec2[T]) T {
 	return v.X*vec.X + v.Y*vec.Y
 }
 
+// Cross returns the scalar cross product of this vector and another vector.
+// Positive = counterclockwise, negative = clockwise, zero = collinear.
+func (v Vec2[T]) Cross(vec Vec2[T]) T {
+	return v.X*vec.Y - v.Y*vec.X
+}
+
 // Lerp interpolates between this vector and another vector.
-func (v *Vector2) Lerp(vec Vector2, t float64) {
+// The t value is not clamped, so values outside [0, 1] extrapolate, the same as LerpUnclamped.
+func (v *Vec2[T]) Lerp(vec Vec2[T], t T) {
 	v.X += (vec.X - v.X) * t
 	v.Y += (vec.Y - v.Y) * t
 }
 
+// LerpUnclamped interpolates between this vector and a target vector.
+// Values of t outside [0, 1] extrapolate beyond this vector or the target.
+func (v *Vec2[T]) LerpUnclamped(target Vec2[T], t T) {
+	v.Lerp(target, t)
+}
+
+// LerpClamped interpolates between this vector and a target vector.
+// The t value is clamped to [0, 1], so the result never moves past the target.
+func (v *Vec2[T]) LerpClamped(target Vec2[T], t T) {
+	v.Lerp(target, max(0, min(1, t)))
+}
+
 // ClampMagnitude limits the magnitude of the vector to a maximum value.
-func (v *Vector2) ClampMagnitude(maxValue float64) {
+func (v *Vec2[T]) ClampMagnitude(maxValue T) {
 	maxSquared := maxValue * maxValue
 	magnitudeSquared := v.MagnitudeSquared()
 
@@ -155,22 +521,1175 @@ func (v *Vector2) ClampMagnitude(maxValue float64) {
 		return
 	}
 
-	scale := maxValue / math.Sqrt(magnitudeSquared)
+	scale := maxValue / sqrt(magnitudeSquared)
 	v.X *= scale
 	v.Y *= scale
 }
 
 // Clear sets the vector to zero.
-func (v *Vector2) Clear() {
+func (v *Vec2[T]) Clear() {
 	v.X = 0
 	v.Y = 0
 }
 
-// ToVector3 converts the 2D vector to a 3D vector.
-func (v Vector2) ToVector3() Vector3 {
-	return Vector3{
-		X: v.X,
-		Y: v.Y,
-		Z: 0,
+// Reflect reflects this vector across the plane defined by a normal.
+// The normal is assumed to be normalized.
+func (v *Vec2[T]) Reflect(normal Vec2[T]) {
+	dot := 2 * v.Dot(normal)
+	v.X -= dot * normal.X
+	v.Y -= dot * normal.Y
+}
+
+// Project replaces this vector with its projection onto another vector.
+// If the other vector is zero, this vector is cleared.
+func (v *Vec2[T]) Project(onto Vec2[T]) {
+	ontoSquared := onto.MagnitudeSquared()
+
+	if ontoSquared == 0 {
+		v.Clear()
+
+		return
+	}
+
+	scale := v.Dot(onto) / ontoSquared
+	v.X = onto.X * scale
+	v.Y = onto.Y * scale
+}
+
+// Reject replaces this vector with the component of it that is perpendicular to another vector.
+func (v *Vec2[T]) Reject(from Vec2[T]) {
+	projection := *v
+	projection.Project(from)
+	v.Sub(projection)
+}
+
+// String returns the vector formatted as "(x, y)", such as "(1.5, 2.0)".
+// Whole numbers are written with a trailing ".0", and the output can be parsed back with ParseVector2.
+func (v Vec2[T]) String() string {
+	return "(" + formatComponent(v.X) + ", " + formatComponent(v.Y) + ")"
+}
+
+// GoString returns the vector formatted as a Go expression, for use with %#v.
+func (v Vec2[T]) GoString() string {
+	return fmt.Sprintf("vectors.%s{X: %#v, Y: %#v}", v.typeName(), v.X, v.Y)
+}
+
+// typeName returns the name of the concrete vector type, for use in error messages.
+func (v Vec2[T]) typeName() string {
+	if bitSize[T]() == 32 {
+		return "Vector2f32"
+	}
+
+	return "Vector2"
+}
+
+type vec2JSON[T Float] struct {
+	X T `json:"x"`
+	Y T `json:"y"`
+}
+
+// MarshalJSON encodes the vector as a JSON object with "x" and "y" keys.
+func (v Vec2[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vec2JSON[T](v))
+}
+
+// UnmarshalJSON decodes the vector from a JSON object.
+// Keys are matched case-insensitively, so both "x" and "X" are accepted.
+func (v *Vec2[T]) UnmarshalJSON(data []byte) error {
+	var vec vec2JSON[T]
+
+	err := json.Unmarshal(data, &vec)
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s JSON: %w", v.typeName(), err)
+	}
+
+	*v = Vec2[T](vec)
+
+	return nil
+}
+
+// MarshalBinary encodes the vector as little-endian IEEE 754 values,
+// using 8 bytes for float32 components and 16 bytes for float64 components.
+func (v Vec2[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 2*bitSize[T]()/8)
+	data = appendBinaryFloat(data, v.X)
+	data = appendBinaryFloat(data, v.Y)
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes the vector from little-endian IEEE 754 values,
+// using 8 bytes for float32 components and 16 bytes for float64 components.
+func (v *Vec2[T]) UnmarshalBinary(data []byte) error {
+	size := bitSize[T]() / 8
+
+	if len(data) != 2*size {
+		return fmt.Errorf("vectors: invalid %s binary length %d, expected %d", v.typeName(), len(data), 2*size)
+	}
+
+	v.X = readBinaryFloat[T](data[0:size])
+	v.Y = readBinaryFloat[T](data[size : 2*size])
+
+	return nil
+}
+
+// Equal checks if all axes are exactly equal to those of another vector.
+func (v Vec2[T]) Equal(vec Vec2[T]) bool {
+	return v.X == vec.X && v.Y == vec.Y
+}
+
+// ApproxEqual checks if all axes differ from those of another vector by less than epsilon.
+func (v Vec2[T]) ApproxEqual(vec Vec2[T], epsilon T) bool {
+	return abs(v.X-vec.X) < epsilon &&
+		abs(v.Y-vec.Y) < epsilon
+}
+
+// Clamp limits each axis of the vector to the range of the same axis in two other vectors.
+// It panics if the minimum is greater than the maximum on any axis.
+func (v *Vec2[T]) Clamp(minValue, maxValue Vec2[T]) {
+	if minValue.X > maxValue.X {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum on the X axis")
+	}
+
+	if minValue.Y > maxValue.Y {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum on the Y axis")
+	}
+
+	v.X = max(minValue.X, min(maxValue.X, v.X))
+	v.Y = max(minValue.Y, min(maxValue.Y, v.Y))
+}
+
+// ClampScalar limits each axis of the vector to the same range.
+// It panics if the minimum is greater than the maximum.
+func (v *Vec2[T]) ClampScalar(minValue, maxValue T) {
+	if minValue > maxValue {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum")
+	}
+
+	v.X = max(minValue, min(maxValue, v.X))
+	v.Y = max(minValue, min(maxValue, v.Y))
+}
+
+// Abs replaces each axis of the vector with its absolute value.
+func (v *Vec2[T]) Abs() {
+	v.X = abs(v.X)
+	v.Y = abs(v.Y)
+}
+
+// Absed returns a copy of this vector with each axis replaced by its absolute value.
+func (v Vec2[T]) Absed() Vec2[T] {
+	v.Abs()
+
+	return v
+}
+
+// Floor rounds each axis of the vector down to the nearest integer.
+func (v *Vec2[T]) Floor() {
+	v.X = floor(v.X)
+	v.Y = floor(v.Y)
+}
+
+// Ceil rounds each axis of the vector up to the nearest integer.
+func (v *Vec2[T]) Ceil() {
+	v.X = ceil(v.X)
+	v.Y = ceil(v.Y)
+}
+
+// Round rounds each axis of the vector to the nearest integer, rounding half away from zero.
+func (v *Vec2[T]) Round() {
+	v.X = round(v.X)
+	v.Y = round(v.Y)
+}
+
+// Trunc removes the fractional part of each axis of the vector.
+func (v *Vec2[T]) Trunc() {
+	v.X = trunc(v.X)
+	v.Y = trunc(v.Y)
+}
+
+// ComponentMin sets each axis of the vector to the minimum of itself and the same axis of another vector.
+func (v *Vec2[T]) ComponentMin(vec Vec2[T]) {
+	v.X = min(v.X, vec.X)
+	v.Y = min(v.Y, vec.Y)
+}
+
+// ComponentMax sets each axis of the vector to the maximum of itself and the same axis of another vector.
+func (v *Vec2[T]) ComponentMax(vec Vec2[T]) {
+	v.X = max(v.X, vec.X)
+	v.Y = max(v.Y, vec.Y)
+}
+
+// ComponentMinVector2 returns a new vector with the minimum of each axis of two vectors.
+func ComponentMinVector2(a, b Vector2) Vector2 {
+	a.ComponentMin(b)
+
+	return a
+}
+
+// ComponentMaxVector2 returns a new vector with the maximum of each axis of two vectors.
+func ComponentMaxVector2(a, b Vector2) Vector2 {
+	a.ComponentMax(b)
+
+	return a
+}
+
+// ZipVector2 returns a new vector with a function applied to each pair of axes of two vectors,
+// such as ZipVector2(a, b, math.Max).
+func ZipVector2(a, b Vector2, f func(float64, float64) float64) Vector2 {
+	return Vector2{X: f(a.X, b.X), Y: f(a.Y, b.Y)}
+}
+
+// SumVector2 returns the sum of all vectors in a slice.
+// It returns the zero vector for an empty slice.
+func SumVector2(vecs []Vector2) Vector2 {
+	var sum Vector2
+
+	for _, vec := range vecs {
+		sum.Add(vec)
+	}
+
+	return sum
+}
+
+// AverageVector2 returns the average of all vectors in a slice, such as the centroid of a set of points.
+// It returns the zero vector for an empty slice.
+func AverageVector2(vecs []Vector2) Vector2 {
+	if len(vecs) == 0 {
+		return Vector2{}
+	}
+
+	sum := SumVector2(vecs)
+	sum.Scale(1 / float64(len(vecs)))
+
+	return sum
+}
+
+// WeightedAverageVector2 returns the sum of each vector multiplied by its weight, divided by the sum of the weights.
+// It returns ErrLengthMismatch if the slices have different lengths,
+// and ErrZeroTotalWeight if the weights sum to zero.
+// This covers empty slices and all-zero weights, as well as non-zero weights that cancel out, such as [1, -1].
+func WeightedAverageVector2(vecs []Vector2, weights []float64) (Vector2, error) {
+	if len(vecs) != len(weights) {
+		return Vector2{}, fmt.Errorf("%w: %d vectors and %d weights", ErrLengthMismatch, len(vecs), len(weights))
+	}
+
+	var sum Vector2
+	var totalWeight float64
+
+	for i, vec := range vecs {
+		sum.Add(vec.Scaled(weights[i]))
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		return Vector2{}, ErrZeroTotalWeight
+	}
+
+	sum.Scale(1 / totalWeight)
+
+	return sum, nil
+}
+
+// BiLerpVector2 bilinearly interpolates between the four corners of a quad patch.
+// The corners a, b, c, and d are at (s, t) = (0, 0), (1, 0), (0, 1), and (1, 1) respectively.
+func BiLerpVector2(a, b, c, d Vector2, s, t float64) Vector2 {
+	a.Lerp(b, s)
+	c.Lerp(d, s)
+	a.Lerp(c, t)
+
+	return a
+}
+
+// Rotate rotates the vector counterclockwise by an angle in radians.
+func (v *Vec2[T]) Rotate(radians T) {
+	sin, cos := sincos(radians)
+	x := v.X*cos - v.Y*sin
+	y := v.X*sin + v.Y*cos
+	v.X = T(x)
+	v.Y = T(y)
+}
+
+// RotateDegrees rotates the vector counterclockwise by an angle in degrees.
+func (v *Vec2[T]) RotateDegrees(degrees T) {
+	v.Rotate(degrees * math.Pi / 180)
+}
+
+// RotateAround rotates the vector counterclockwise around a pivot by an angle in radians.
+func (v *Vec2[T]) RotateAround(pivot Vec2[T], radians T) {
+	v.Sub(pivot)
+	v.Rotate(radians)
+	v.Add(pivot)
+}
+
+// RotateAroundDegrees rotates the vector counterclockwise around a pivot by an angle in degrees.
+func (v *Vec2[T]) RotateAroundDegrees(pivot Vec2[T], degrees T) {
+	v.RotateAround(pivot, degrees*math.Pi/180)
+}
+
+// Perpendicular rotates the vector 90 degrees counterclockwise.
+func (v *Vec2[T]) Perpendicular() {
+	v.X, v.Y = -v.Y, v.X
+}
+
+// PerpendicularVector returns a copy of this vector rotated 90 degrees counterclockwise.
+func (v Vec2[T]) PerpendicularVector() Vec2[T] {
+	v.Perpendicular()
+
+	return v
+}
+
+// PerpendicularClockwise rotates the vector 90 degrees clockwise.
+func (v *Vec2[T]) PerpendicularClockwise() {
+	v.X, v.Y = v.Y, -v.X
+}
+
+// PerpendicularClockwiseVector returns a copy of this vector rotated 90 degrees clockwise.
+func (v Vec2[T]) PerpendicularClockwiseVector() Vec2[T] {
+	v.PerpendicularClockwise()
+
+	return v
+}
+
+// MoveTowards moves the vector towards a target by at most maxDelta units.
+// The vector stops exactly at the target instead of overshooting it.
+func (v *Vec2[T]) MoveTowards(target Vec2[T], maxDelta T) {
+	delta := target.Subbed(*v)
+	distance := delta.Magnitude()
+
+	if distance <= maxDelta || distance == 0 {
+		*v = target
+
+		return
+	}
+
+	delta.Scale(maxDelta / distance)
+	v.Add(delta)
+}
+
+// SmoothStep interpolates between this vector and a target vector using the cubic 3t²-2t³ curve.
+// The value of t is clamped to the range [0, 1].
+func (v *Vec2[T]) SmoothStep(target Vec2[T], t T) {
+	if t >= 1 {
+		*v = target
+
+		return
+	}
+
+	t = max(0, t)
+	v.Lerp(target, t*t*(3-2*t))
+}
+
+// SmootherStep interpolates between this vector and a target vector using the quintic 6t⁵-15t⁴+10t³ curve.
+// The value of t is clamped to the range [0, 1].
+func (v *Vec2[T]) SmootherStep(target Vec2[T], t T) {
+	if t >= 1 {
+		*v = target
+
+		return
+	}
+
+	t = max(0, t)
+	v.Lerp(target, t*t*t*(t*(t*6-15)+10))
+}
+
+// SafeDiv divides this vector by another vector.
+// It returns an error naming the axis if any axis of the other vector is zero, leaving this vector unchanged.
+func (v *Vec2[T]) SafeDiv(vec Vec2[T]) error {
+	if vec.X == 0 {
+		return fmt.Errorf("%w on the X axis", ErrDivisionByZero)
+	}
+
+	if vec.Y == 0 {
+		return fmt.Errorf("%w on the Y axis", ErrDivisionByZero)
+	}
+
+	v.Div(vec)
+
+	return nil
+}
+
+// ClampMagnitudeRange limits the magnitude of the vector to the range [minValue, maxValue].
+// A zero vector has no direction, so it is left unchanged even if minValue is greater than zero.
+// It panics if minValue is greater than maxValue.
+func (v *Vec2[T]) ClampMagnitudeRange(minValue, maxValue T) {
+	if minValue > maxValue {
+		panic("vectors: " + v.typeName() + " clamp magnitude minimum is greater than maximum")
+	}
+
+	magnitudeSquared := v.MagnitudeSquared()
+
+	if magnitudeSquared == 0 {
+		return
+	}
+
+	if magnitudeSquared < minValue*minValue {
+		v.Scale(minValue / sqrt(magnitudeSquared))
+
+		return
+	}
+
+	v.ClampMagnitude(maxValue)
+}
+
+// SetMagnitude scales the vector to have a specific magnitude while keeping its direction.
+// A zero vector has no direction, so it is left unchanged.
+func (v *Vec2[T]) SetMagnitude(magnitude T) {
+	v.Normalize()
+	v.Scale(magnitude)
+}
+
+// IsParallel checks if the absolute cross product with another vector is less than epsilon.
+// This includes vectors pointing in opposite directions.
+func (v Vec2[T]) IsParallel(vec Vec2[T], epsilon T) bool {
+	return abs(v.Cross(vec)) < epsilon
+}
+
+// IsPerpendicular checks if the absolute dot product with another vector is less than epsilon.
+func (v Vec2[T]) IsPerpendicular(vec Vec2[T], epsilon T) bool {
+	return abs(v.Dot(vec)) < epsilon
+}
+
+// Clone returns a copy of the vector.
+func (v Vec2[T]) Clone() Vec2[T] {
+	return v
+}
+
+// SnapToGrid rounds each axis of the vector to the nearest multiple of a grid size.
+// It panics if the grid size is zero.
+func (v *Vec2[T]) SnapToGrid(gridSize T) {
+	v.SnapToGridXY(gridSize, gridSize)
+}
+
+// SnapToGridXY rounds each axis of the vector to the nearest multiple of a separate grid size per axis.
+// It panics if any grid size is zero.
+func (v *Vec2[T]) SnapToGridXY(gridX, gridY T) {
+	if gridX == 0 || gridY == 0 {
+		panic("vectors: " + v.typeName() + " grid size must not be zero")
+	}
+
+	v.X = round(v.X/gridX) * gridX
+	v.Y = round(v.Y/gridY) * gridY
+}
+
+// ComponentSum returns the sum of all axes.
+func (v Vec2[T]) ComponentSum() T {
+	return v.X + v.Y
+}
+
+// ComponentProduct returns the product of all axes.
+func (v Vec2[T]) ComponentProduct() T {
+	return v.X * v.Y
+}
+
+// MaxComponent returns the value of the largest axis.
+func (v Vec2[T]) MaxComponent() T {
+	return max(v.X, v.Y)
+}
+
+// MinComponent returns the value of the smallest axis.
+func (v Vec2[T]) MinComponent() T {
+	return min(v.X, v.Y)
+}
+
+// MaxComponentIndex returns the index of the largest axis, where 0 = X and 1 = Y.
+// If multiple axes are equal, the lowest index is returned.
+func (v Vec2[T]) MaxComponentIndex() int {
+	if v.Y > v.X {
+		return 1
+	}
+
+	return 0
+}
+
+// MinComponentIndex returns the index of the smallest axis, where 0 = X and 1 = Y.
+// If multiple axes are equal, the lowest index is returned.
+func (v Vec2[T]) MinComponentIndex() int {
+	if v.Y < v.X {
+		return 1
+	}
+
+	return 0
+}
+
+// SafeNormalize scales the vector to have a magnitude of 1.
+// If the vector is zero, it is set to the fallback instead, which is assumed to be normalized.
+func (v *Vec2[T]) SafeNormalize(fallback Vec2[T]) {
+	if v.IsZero() {
+		*v = fallback
+
+		return
+	}
+
+	v.Normalize()
+}
+
+// MarshalText encodes the vector as text in the "x,y" format.
+func (v Vec2[T]) MarshalText() ([]byte, error) {
+	var data []byte
+	data = strconv.AppendFloat(data, float64(v.X), 'g', -1, bitSize[T]())
+	data = append(data, ',')
+	data = strconv.AppendFloat(data, float64(v.Y), 'g', -1, bitSize[T]())
+
+	return data, nil
+}
+
+// UnmarshalText decodes the vector from text in the "x,y" format.
+func (v *Vec2[T]) UnmarshalText(data []byte) error {
+	parts := strings.Split(string(data), ",")
+
+	if len(parts) != 2 {
+		return fmt.Errorf("vectors: invalid %s text %q, expected 2 components", v.typeName(), data)
+	}
+
+	x, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), bitSize[T]())
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s X component: %w", v.typeName(), err)
+	}
+
+	y, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), bitSize[T]())
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s Y component: %w", v.typeName(), err)
+	}
+
+	v.X = T(x)
+	v.Y = T(y)
+
+	return nil
+}
+
+// ManhattanDistance returns the L1 distance between this vector and another vector,
+// which is the sum of the absolute differences of each axis.
+func (v Vec2[T]) ManhattanDistance(vec Vec2[T]) T {
+	return abs(v.X-vec.X) + abs(v.Y-vec.Y)
+}
+
+// ChebyshevDistance returns the L∞ distance between this vector and another vector,
+// which is the largest absolute difference of any axis.
+func (v Vec2[T]) ChebyshevDistance(vec Vec2[T]) T {
+	return max(abs(v.X-vec.X), abs(v.Y-vec.Y))
+}
+
+// MinkowskiDistance returns the Lp distance between this vector and another vector.
+// A p of 1 is equal to ManhattanDistance and a p of 2 is equal to Distance.
+func (v Vec2[T]) MinkowskiDistance(vec Vec2[T], p T) T {
+	return pow(
+		pow(abs(v.X-vec.X), p)+pow(abs(v.Y-vec.Y), p),
+		1/p,
+	)
+}
+
+// CosineSimilarity returns the cosine of the angle between this vector and another vector.
+// The result is 1 for the same direction, -1 for opposite directions, and 0 for perpendicular vectors.
+// It returns 0 if either vector is zero.
+func (v Vec2[T]) CosineSimilarity(vec Vec2[T]) T {
+	magnitudes := v.Magnitude() * vec.Magnitude()
+
+	if magnitudes == 0 {
+		return 0
+	}
+
+	return v.Dot(vec) / magnitudes
+}
+
+// Wrap wraps each axis of the vector into the range [minValue, maxValue) of the same axis,
+// as in a toroidal world. If an axis has an empty range, it is set to the minimum of that axis.
+func (v *Vec2[T]) Wrap(minValue, maxValue Vec2[T]) {
+	v.X = wrapFloat(v.X, minValue.X, maxValue.X)
+	v.Y = wrapFloat(v.Y, minValue.Y, maxValue.Y)
+}
+
+// WrapScalar wraps each axis of the vector into the range [minValue, maxValue).
+// If the range is empty, all axes are set to minValue.
+func (v *Vec2[T]) WrapScalar(minValue, maxValue T) {
+	v.X = wrapFloat(v.X, minValue, maxValue)
+	v.Y = wrapFloat(v.Y, minValue, maxValue)
+}
+
+// ToroidalDistance returns the shortest distance between this vector and another vector
+// in a world that wraps around at the given size on each axis.
+// Each axis of the size must be positive.
+func (v Vec2[T]) ToroidalDistance(vec, size Vec2[T]) T {
+	return hypot(
+		toroidalDelta(v.X, vec.X, size.X),
+		toroidalDelta(v.Y, vec.Y, size.Y),
+	)
+}
+
+// ClosestPointOnSegment returns the point on the line segment from a to b that is closest to this vector.
+// If a and b are equal, a is returned.
+func (v Vec2[T]) ClosestPointOnSegment(a, b Vec2[T]) Vec2[T] {
+	segment := b.Subbed(a)
+	lengthSquared := segment.MagnitudeSquared()
+
+	if lengthSquared == 0 {
+		return a
+	}
+
+	t := v.Subbed(a).Dot(segment) / lengthSquared
+	t = max(0, min(1, t))
+
+	return a.Added(segment.Scaled(t))
+}
+
+// DistanceToSegment returns the distance from this vector to the closest point on the line segment from a to b.
+// If a and b are equal, the distance to a is returned.
+func (v Vec2[T]) DistanceToSegment(a, b Vec2[T]) T {
+	return v.Distance(v.ClosestPointOnSegment(a, b))
+}
+
+// Get returns the axis at an index, where 0 = X and 1 = Y.
+// It panics if the index is out of range.
+func (v Vec2[T]) Get(i int) T {
+	switch i {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	}
+
+	panic(fmt.Sprintf("vectors: %s index %d out of range", v.typeName(), i))
+}
+
+// Set sets the axis at an index, where 0 = X and 1 = Y.
+// It panics if the index is out of range.
+func (v *Vec2[T]) Set(i int, val T) {
+	switch i {
+	case 0:
+		v.X = val
+	case 1:
+		v.Y = val
+	default:
+		panic(fmt.Sprintf("vectors: %s index %d out of range", v.typeName(), i))
+	}
+}
+
+// Len returns the number of axes, which is always 2.
+func (v Vec2[T]) Len() int {
+	return 2
+}
+
+// Components returns the axes of the vector in order as a newly allocated slice.
+func (v Vec2[T]) Components() []T {
+	return v.ToSlice()
+}
+
+// Map returns a new vector with a function applied to each axis, such as v.Map(math.Abs).
+func (v Vec2[T]) Map(f func(T) T) Vec2[T] {
+	return Vec2[T]{X: f(v.X), Y: f(v.Y)}
+}
+
+// Saturate clamps each axis of the vector to [0, 1].
+func (v *Vec2[T]) Saturate() {
+	v.ClampScalar(0, 1)
+}
+
+// Saturated returns a copy of this vector with each axis clamped to [0, 1].
+func (v Vec2[T]) Saturated() Vec2[T] {
+	v.Saturate()
+
+	return v
+}
+
+// Fract replaces each axis of the vector with its fractional part, which is always in [0, 1).
+// Negative values wrap upward, so -0.25 becomes 0.75.
+func (v *Vec2[T]) Fract() {
+	v.X -= floor(v.X)
+	v.Y -= floor(v.Y)
+}
+
+// Fracted returns a copy of this vector with each axis replaced by its fractional part.
+func (v Vec2[T]) Fracted() Vec2[T] {
+	v.Fract()
+
+	return v
+}
+
+// Step returns a new vector with each axis set to 0 if it is less than the same axis of an edge, and 1 otherwise,
+// like the GLSL step function.
+func (v Vec2[T]) Step(edge Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: stepFloat(edge.X, v.X),
+		Y: stepFloat(edge.Y, v.Y),
+	}
+}
+
+// SmoothStepComponent returns a new vector with each axis smoothly mapped from the range between two edges to [0, 1]
+// using the cubic 3t²-2t³ curve, like the GLSL smoothstep function.
+// Axes at or below edge0 become 0 and axes at or above edge1 become 1.
+// If both edges are equal on an axis, that axis behaves like Step.
+func (v Vec2[T]) SmoothStepComponent(edge0, edge1 Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: smoothStepFloat(edge0.X, edge1.X, v.X),
+		Y: smoothStepFloat(edge0.Y, edge1.Y, v.Y),
+	}
+}
+
+// Quantize rounds each axis of the vector to a number of decimal places.
+// A precision of 0 rounds to integers, and a negative precision rounds to tens, hundreds, and so on,
+// so a precision of -2 rounds to the nearest multiple of 100.
+// An axis is left unchanged if 10^precision or the scaled axis cannot be represented in the vector's float type.
+func (v *Vec2[T]) Quantize(precision int) {
+	scale := T(math.Pow(10, float64(precision)))
+
+	v.X = quantizeFloat(v.X, scale)
+	v.Y = quantizeFloat(v.Y, scale)
+}
+
+// QuantizeToStep rounds each axis of the vector to the nearest multiple of a step, such as 0.25.
+// It panics if the step is zero.
+func (v *Vec2[T]) QuantizeToStep(step T) {
+	v.SnapToGrid(step)
+}
+
+// ReflectWithRestitution reflects this vector across the plane defined by a normal, scaling the bounce by a restitution.
+// A restitution of 1 is a perfect bounce like Reflect, and 0 removes the normal component so the vector slides along the plane.
+// The normal is assumed to be normalized.
+func (v *Vec2[T]) ReflectWithRestitution(normal Vec2[T], restitution T) {
+	v.Sub(normal.Scaled((1 + restitution) * v.Dot(normal)))
+}
+
+// Hash returns the 64-bit FNV-1a hash of the little-endian bit patterns of the axes.
+// Negative zero is hashed as zero, so equal vectors always have equal hashes.
+func (v Vec2[T]) Hash() uint64 {
+	if v.X == 0 {
+		v.X = 0
+	}
+
+	if v.Y == 0 {
+		v.Y = 0
+	}
+
+	data, _ := v.MarshalBinary()
+
+	hash := fnv.New64a()
+	_, _ = hash.Write(data)
+
+	return hash.Sum64()
+}
+
+// QuantizedHash returns the hash of the vector after rounding each axis to the nearest multiple of a step,
+// so nearly equal vectors can share a hash bucket.
+// It panics if the step is zero.
+func (v Vec2[T]) QuantizedHash(step T) uint64 {
+	v.QuantizeToStep(step)
+
+	return v.Hash()
+}
+
+// AngleRelativeTo returns the signed angle in radians from a reference vector to this vector.
+// The result is in the range (-π, π], where positive values are counterclockwise from the reference.
+// With a reference of (1, 0), it matches AngleRadians.
+func (v Vec2[T]) AngleRelativeTo(reference Vec2[T]) T {
+	return reference.SignedAngleTo(v)
+}
+
+// AngleRelativeToDegrees returns the signed angle in degrees from a reference vector to this vector.
+// The result is in the range (-180, 180], where positive values are counterclockwise from the reference.
+func (v Vec2[T]) AngleRelativeToDegrees(reference Vec2[T]) T {
+	return reference.SignedAngleToDegrees(v)
+}
+
+// DirectionTo returns the unit vector pointing from this vector to a target.
+// If both vectors are equal, the zero vector is returned.
+func (v Vec2[T]) DirectionTo(target Vec2[T]) Vec2[T] {
+	direction := target.Subbed(v)
+	direction.Normalize()
+
+	return direction
+}
+
+// DirectionFrom returns the unit vector pointing from a source to this vector.
+// If both vectors are equal, the zero vector is returned.
+func (v Vec2[T]) DirectionFrom(source Vec2[T]) Vec2[T] {
+	direction := v.Subbed(source)
+	direction.Normalize()
+
+	return direction
+}
+
+// XY returns a copy of this vector with the X and Y axes in their original order.
+func (v Vec2[T]) XY() Vec2[T] {
+	return v
+}
+
+// YX returns a copy of this vector with the X and Y axes swapped.
+func (v Vec2[T]) YX() Vec2[T] {
+	return Vec2[T]{X: v.Y, Y: v.X}
+}
+
+// ToVector3WithZ converts the 2D vector to a 3D vector with the given Z axis.
+func (v Vec2[T]) ToVector3WithZ(z T) Vec3[T] {
+	return Vec3[T]{
+		X: v.X,
+		Y: v.Y,
+		Z: z,
+	}
+}
+
+// MirrorX negates the X axis, mirroring the vector across the Y axis.
+func (v *Vec2[T]) MirrorX() {
+	v.X = -v.X
+}
+
+// MirrorY negates the Y axis, mirroring the vector across the X axis.
+func (v *Vec2[T]) MirrorY() {
+	v.Y = -v.Y
+}
+
+// MirrorAcross mirrors the vector across the line through the origin in the direction of an axis.
+// The axis does not need to be normalized. If the axis is zero, the vector is negated.
+func (v *Vec2[T]) MirrorAcross(axis Vec2[T]) {
+	projection := *v
+	projection.Project(axis)
+	projection.Scale(2)
+
+	*v = projection.Subbed(*v)
+}
+
+// ScaleAround scales the distance of the vector from a pivot point, keeping its direction from the pivot.
+func (v *Vec2[T]) ScaleAround(pivot Vec2[T], scale T) {
+	v.Sub(pivot)
+	v.Scale(scale)
+	v.Add(pivot)
+}
+
+// ScaleAroundNonUniform scales the offset of the vector from a pivot point by a separate scale per axis.
+func (v *Vec2[T]) ScaleAroundNonUniform(pivot, scale Vec2[T]) {
+	v.Sub(pivot)
+	v.Mul(scale)
+	v.Add(pivot)
+}
+
+// Reciprocal replaces each axis of the vector with 1 divided by that axis.
+// Zero axes become infinite, following the usual IEEE 754 semantics.
+func (v *Vec2[T]) Reciprocal() {
+	v.X = 1 / v.X
+	v.Y = 1 / v.Y
+}
+
+// RecipMul returns a copy of this vector multiplied by the reciprocal of each axis of another vector.
+// Zero axes of the other vector produce infinite or NaN axes, following the usual IEEE 754 semantics.
+func (v Vec2[T]) RecipMul(vec Vec2[T]) Vec2[T] {
+	vec.Reciprocal()
+	v.Mul(vec)
+
+	return v
+}
+
+// Decompose splits the vector into a component parallel to an axis and a component perpendicular to it,
+// which add up to the original vector.
+// The axis does not need to be normalized. If the axis is zero, the whole vector is perpendicular.
+func (v Vec2[T]) Decompose(axis Vec2[T]) (parallel, perpendicular Vec2[T]) {
+	parallel = v
+	parallel.Project(axis)
+
+	return parallel, v.Subbed(parallel)
+}
+
+// Midpoint returns the point halfway between this vector and another vector.
+// It is computed as (v + vec) * 0.5, which stays finite for components of opposite sign near the float limits.
+func (v Vec2[T]) Midpoint(vec Vec2[T]) Vec2[T] {
+	return Vec2[T]{
+		X: (v.X + vec.X) * 0.5,
+		Y: (v.Y + vec.Y) * 0.5,
+	}
+}
+
+// WeightedMidpoint returns the point between this vector and another vector at a weight,
+// where a weight of 0 returns this vector and a weight of 1 returns the other vector.
+func (v Vec2[T]) WeightedMidpoint(vec Vec2[T], weight T) Vec2[T] {
+	v.Lerp(vec, weight)
+
+	return v
+}
+
+// IsInCircle checks if the vector lies inside a circle, including its boundary.
+func (v Vec2[T]) IsInCircle(center Vec2[T], radius T) bool {
+	return v.DistanceSquared(center) <= radius*radius
+}
+
+// IsOnCircle checks if the distance of the vector from the center of a circle differs from its radius by less than epsilon.
+func (v Vec2[T]) IsOnCircle(center Vec2[T], radius, epsilon T) bool {
+	return abs(v.Distance(center)-radius) < epsilon
+}
+
+// IsInAABB checks if the vector lies inside the axis-aligned bounding box from minValue to maxValue,
+// including its boundary. If the minimum is greater than the maximum on any axis, it returns false.
+func (v Vec2[T]) IsInAABB(minValue, maxValue Vec2[T]) bool {
+	return v.X >= minValue.X && v.X <= maxValue.X &&
+		v.Y >= minValue.Y && v.Y <= maxValue.Y
+}
+
+// IsInAABBExclusive checks if the vector lies strictly inside the axis-aligned bounding box from minValue to maxValue,
+// excluding its boundary. If the minimum is greater than the maximum on any axis, it returns false.
+func (v Vec2[T]) IsInAABBExclusive(minValue, maxValue Vec2[T]) bool {
+	return v.X > minValue.X && v.X < maxValue.X &&
+		v.Y > minValue.Y && v.Y < maxValue.Y
+}
+
+// NearestPointOnLine returns the point on the infinite line through linePoint along lineDir that is closest to this vector.
+// The direction does not need to be normalized. If lineDir has a magnitude of 0, linePoint is returned.
+func (v Vec2[T]) NearestPointOnLine(linePoint, lineDir Vec2[T]) Vec2[T] {
+	lengthSquared := lineDir.MagnitudeSquared()
+
+	if lengthSquared == 0 {
+		return linePoint
+	}
+
+	t := v.Subbed(linePoint).Dot(lineDir) / lengthSquared
+
+	return linePoint.Added(lineDir.Scaled(t))
+}
+
+// DistanceToLine returns the distance from this vector to the infinite line through linePoint along lineDir.
+// If lineDir has a magnitude of 0, the distance to linePoint is returned.
+func (v Vec2[T]) DistanceToLine(linePoint, lineDir Vec2[T]) T {
+	return v.Distance(v.NearestPointOnLine(linePoint, lineDir))
+}
+
+// ToComplex returns the vector as a complex number, with X as the real part and Y as the imaginary part.
+// This allows using complex arithmetic and the math/cmplx package for rotations and similar operations.
+func (v Vec2[T]) ToComplex() complex128 {
+	return complex(float64(v.X), float64(v.Y))
+}
+
+// PackFloat32 encodes the vector as 8 bytes of little-endian IEEE 754 float32 values,
+// matching the layout of a float32 vertex attribute. Precision beyond float32 is lost.
+func (v Vec2[T]) PackFloat32() [8]byte {
+	var b [8]byte
+
+	binary.LittleEndian.PutUint32(b[0:], math.Float32bits(float32(v.X)))
+	binary.LittleEndian.PutUint32(b[4:], math.Float32bits(float32(v.Y)))
+
+	return b
+}
+
+// ToFloat32Array2 returns the coordinates of the vector as an array of float32 values.
+func (v Vec2[T]) ToFloat32Array2() [2]float32 {
+	return [2]float32{float32(v.X), float32(v.Y)}
+}
+
+// MortonCode returns the 32-bit Z-order curve index of the vector, for spatial indexing such as quadtrees.
+// Each axis is mapped to (value+offset)*scale, floored, and clamped to 16 bits before the bits are interleaved,
+// with X in the even bits and Y in the odd bits.
+func (v Vec2[T]) MortonCode(scale, offset T) uint64 {
+	x := mortonQuantize(float64(v.X), float64(scale), float64(offset), 16)
+	y := mortonQuantize(float64(v.Y), float64(scale), float64(offset), 16)
+
+	return spreadBits2(x) | spreadBits2(y)<<1
+}
+
+// ToArray returns the coordinates of the vector as an array.
+func (v Vec2[T]) ToArray() [2]T {
+	return [2]T{v.X, v.Y}
+}
+
+// ToSlice returns the coordinates of the vector as a newly allocated slice.
+func (v Vec2[T]) ToSlice() []T {
+	return []T{v.X, v.Y}
+}
+
+// ToVector2i converts the vector to an integer vector, truncating each component toward zero.
+func (v Vec2[T]) ToVector2i() Vector2i {
+	return Vector2i{
+		X: int(v.X),
+		Y: int(v.Y),
+	}
+}
+
+// ToVector3 converts the 2D vector to a 3D vector.
+func (v Vec2[T]) ToVector3() Vec3[T] {
+	return Vec3[T]{
+		X: v.X,
+		Y: v.Y,
+		Z: 0,
+	}
+}
+
+// LookAt2D returns the unit direction vector pointing from one point to another.
+// If both points are equal, the zero vector is returned.
+func LookAt2D(from, to Vector2) Vector2 {
+	direction := to.Subbed(from)
+	direction.Normalize()
+
+	return direction
+}
+
+// HermiteVector2 interpolates along a cubic Hermite spline from p0 to p1,
+// with t0 and t1 as the tangents at p0 and p1.
+// A t of 0 returns p0 and a t of 1 returns p1.
+func HermiteVector2(p0, p1, t0, t1 Vector2, t float64) Vector2 {
+	t2 := t * t
+	t3 := t2 * t
+
+	result := p0.Scaled(2*t3 - 3*t2 + 1)
+	result.Add(t0.Scaled(t3 - 2*t2 + t))
+	result.Add(p1.Scaled(-2*t3 + 3*t2))
+	result.Add(t1.Scaled(t3 - t2))
+
+	return result
+}
+
+// CatmullRomVector2 interpolates along a uniform Catmull-Rom spline segment between p1 and p2,
+// using p0 and p3 as the neighboring control points.
+// A t of 0 returns p1 and a t of 1 returns p2.
+func CatmullRomVector2(p0, p1, p2, p3 Vector2, t float64) Vector2 {
+	t1 := p2.Subbed(p0)
+	t1.Scale(0.5)
+
+	t2 := p3.Subbed(p1)
+	t2.Scale(0.5)
+
+	return HermiteVector2(p1, p2, t1, t2, t)
+}
+
+// CatmullRomChain2 interpolates along a Catmull-Rom spline passing through all points,
+// where a t of 0 returns the first point and a t of 1 returns the last point.
+// Each segment covers an equal part of t, and t is clamped to [0, 1].
+// The first and last points are repeated to act as their own neighbors.
+// It returns the zero vector for an empty slice.
+func CatmullRomChain2(points []Vector2, t float64) Vector2 {
+	if len(points) == 0 {
+		return Vector2{}
+	}
+
+	if len(points) == 1 {
+		return points[0]
+	}
+
+	segments := len(points) - 1
+	position := math.Max(0, math.Min(1, t)) * float64(segments)
+	segment := min(int(position), segments-1)
+
+	p0 := points[max(segment-1, 0)]
+	p1 := points[segment]
+	p2 := points[segment+1]
+	p3 := points[min(segment+2, segments)]
+
+	return CatmullRomVector2(p0, p1, p2, p3, position-float64(segment))
+}
+
+// SpringVector2 moves a vector towards a target with a critically damped spring, updating the current position and velocity in place.
+// The smoothTime is roughly the time it takes to reach the target, and is limited to a minimum of 0.0001.
+// Call it once per frame with the elapsed deltaTime, keeping the same velocity between calls.
+func SpringVector2(current, target, velocity *Vector2, smoothTime, deltaTime float64) {
+	omega := 2 / math.Max(0.0001, smoothTime)
+	x := omega * deltaTime
+	decay := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := current.Subbed(*target)
+
+	temp := velocity.Added(change.Scaled(omega))
+	temp.Scale(deltaTime)
+
+	velocity.Sub(temp.Scaled(omega))
+	velocity.Scale(decay)
+
+	change.Add(temp)
+	change.Scale(decay)
+	*current = target.Added(change)
+}
+
+// QuadraticBezier2 returns the point at t on the quadratic Bézier curve with control points p0, p1, and p2.
+// A t of 0 returns p0 and a t of 1 returns p2.
+func QuadraticBezier2(p0, p1, p2 Vector2, t float64) Vector2 {
+	u := 1 - t
+
+	result := p0.Scaled(u * u)
+	result.Add(p1.Scaled(2 * u * t))
+	result.Add(p2.Scaled(t * t))
+
+	return result
+}
+
+// QuadraticBezierTangent2 returns the derivative at t of the quadratic Bézier curve with control points p0, p1, and p2.
+// The result is not normalized.
+func QuadraticBezierTangent2(p0, p1, p2 Vector2, t float64) Vector2 {
+	result := p1.Subbed(p0)
+	result.Scale(2 * (1 - t))
+	result.Add(p2.Subbed(p1).Scaled(2 * t))
+
+	return result
+}
+
+// CubicBezier2 returns the point at t on the cubic Bézier curve with control points p0, p1, p2, and p3.
+// A t of 0 returns p0 and a t of 1 returns p3.
+func CubicBezier2(p0, p1, p2, p3 Vector2, t float64) Vector2 {
+	u := 1 - t
+
+	result := p0.Scaled(u * u * u)
+	result.Add(p1.Scaled(3 * u * u * t))
+	result.Add(p2.Scaled(3 * u * t * t))
+	result.Add(p3.Scaled(t * t * t))
+
+	return result
+}
+
+// CubicBezierTangent2 returns the derivative at t of the cubic Bézier curve with control points p0, p1, p2, and p3.
+// The result is not normalized.
+func CubicBezierTangent2(p0, p1, p2, p3 Vector2, t float64) Vector2 {
+	u := 1 - t
+
+	result := p1.Subbed(p0)
+	result.Scale(3 * u * u)
+	result.Add(p2.Subbed(p1).Scaled(6 * u * t))
+	result.Add(p3.Subbed(p2).Scaled(3 * t * t))
+
+	return result
+}
+
+// ClosestVector2InSlice returns the candidate closest to a query vector, along with its index and distance.
+// If multiple candidates are equally close, the first one is returned.
+// It returns the zero vector, -1, and 0 for an empty slice.
+func ClosestVector2InSlice(query Vector2, candidates []Vector2) (Vector2, int, float64) {
+	return extremeVector2InSlice(query, candidates, func(a, b float64) bool { return a < b })
+}
+
+// FurthestVector2InSlice returns the candidate furthest from a query vector, along with its index and distance.
+// If multiple candidates are equally far, the first one is returned.
+// It returns the zero vector, -1, and 0 for an empty slice.
+func FurthestVector2InSlice(query Vector2, candidates []Vector2) (Vector2, int, float64) {
+	return extremeVector2InSlice(query, candidates, func(a, b float64) bool { return a > b })
+}
+
+// extremeVector2InSlice returns the first candidate whose squared distance to the query is better than all others.
+func extremeVector2InSlice(query Vector2, candidates []Vector2, better func(a, b float64) bool) (Vector2, int, float64) {
+	if len(candidates) == 0 {
+		return Vector2{}, -1, 0
+	}
+
+	index := 0
+	best := query.DistanceSquared(candidates[0])
+
+	for i, candidate := range candidates[1:] {
+		distanceSquared := query.DistanceSquared(candidate)
+
+		if better(distanceSquared, best) {
+			index = i + 1
+			best = distanceSquared
+		}
+	}
+
+	return candidates[index], index, math.Sqrt(best)
+}
+
+// PointOnCircle returns the point on a circle at an angle in radians,
+// measured counterclockwise from the positive X axis.
+func PointOnCircle(center Vector2, radius, angleRadians float64) Vector2 {
+	return PointOnEllipse(center, radius, radius, angleRadians)
+}
+
+// PointOnEllipse returns the point on an axis-aligned ellipse at an angle in radians,
+// measured counterclockwise from the positive X axis.
+// The angle is the parametric angle, so it only matches the polar angle of the point for a circle.
+func PointOnEllipse(center Vector2, radiusX, radiusY, angle float64) Vector2 {
+	sin, cos := math.Sincos(angle)
+
+	return Vector2{
+		X: center.X + radiusX*cos,
+		Y: center.Y + radiusY*sin,
 	}
 }
@@ -0,0 +1,2512 @@
+package vectors
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/cmplx"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestVector2Reflect(t *testing.T) {
+	diagonal := Vector2{X: 1, Y: 1}.Normalized()
+
+	tests := []struct {
+		name   string
+		v      Vector2
+		normal Vector2
+		want   Vector2
+	}{
+		{"horizontal floor", Vector2{X: 1, Y: -1}, Vector2{X: 0, Y: 1}, Vector2{X: 1, Y: 1}},
+		{"vertical wall", Vector2{X: 2, Y: 3}, Vector2{X: -1, Y: 0}, Vector2{X: -2, Y: 3}},
+		{"diagonal", Vector2{X: -1, Y: 0}, diagonal, Vector2{X: 0, Y: 1}},
+		{"along the surface", Vector2{X: 3, Y: 0}, Vector2{X: 0, Y: 1}, Vector2{X: 3, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.Reflect(tt.normal)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Reflect(%v) = %v, want %v", tt.normal, got, tt.want)
+			}
+
+			if !approxEqual(got.Magnitude(), tt.v.Magnitude(), testEpsilon) {
+				t.Errorf("Reflect(%v) changed the magnitude from %v to %v", tt.normal, tt.v.Magnitude(), got.Magnitude())
+			}
+		})
+	}
+}
+
+func TestVector2Project(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector2
+		onto Vector2
+		want Vector2
+	}{
+		{"onto the X axis", Vector2{X: 3, Y: 4}, Vector2{X: 1, Y: 0}, Vector2{X: 3, Y: 0}},
+		{"onto the Y axis", Vector2{X: 3, Y: 4}, Vector2{X: 0, Y: 1}, Vector2{X: 0, Y: 4}},
+		{"onto a non-unit vector", Vector2{X: 3, Y: 4}, Vector2{X: 2, Y: 0}, Vector2{X: 3, Y: 0}},
+		{"onto a diagonal", Vector2{X: 2, Y: 0}, Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: 1}},
+		{"onto a perpendicular vector", Vector2{X: 2, Y: 0}, Vector2{X: 0, Y: 5}, Vector2{}},
+		{"onto the zero vector", Vector2{X: 3, Y: 4}, Vector2{}, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.Project(tt.onto)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Project(%v) = %v, want %v", tt.onto, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2Reject(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector2
+		from Vector2
+	}{
+		{"from the X axis", Vector2{X: 3, Y: 4}, Vector2{X: 1, Y: 0}},
+		{"from a diagonal", Vector2{X: 2, Y: -5}, Vector2{X: 1, Y: 1}},
+		{"from a parallel vector", Vector2{X: 2, Y: 4}, Vector2{X: -1, Y: -2}},
+		{"from the zero vector", Vector2{X: 3, Y: 4}, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projection := tt.v
+			projection.Project(tt.from)
+
+			rejection := tt.v
+			rejection.Reject(tt.from)
+
+			if sum := projection.Added(rejection); !sum.ApproxEqual(tt.v, testEpsilon) {
+				t.Errorf("Project(%v) + Reject(%v) = %v, want %v", tt.from, tt.from, sum, tt.v)
+			}
+
+			if dot := rejection.Dot(tt.from); !approxEqual(dot, 0, testEpsilon) {
+				t.Errorf("Reject(%v) = %v, which has a dot product of %v with it", tt.from, rejection, dot)
+			}
+		})
+	}
+}
+
+func TestVector2JSON(t *testing.T) {
+	vecs := []Vector2{
+		{X: 1.5, Y: 2.3},
+		{X: -0.1, Y: 1e300},
+		{X: math.SmallestNonzeroFloat64, Y: -math.MaxFloat64},
+		{},
+	}
+
+	for _, v := range vecs {
+		data, err := json.Marshal(v)
+
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) returned an error: %v", v, err)
+		}
+
+		var got Vector2
+
+		err = json.Unmarshal(data, &got)
+
+		if err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned an error: %v", data, err)
+		}
+
+		if !got.Equal(v) {
+			t.Errorf("JSON round trip of %v = %v", v, got)
+		}
+	}
+
+	data, _ := json.Marshal(Vector2{X: 1.5, Y: 2.3})
+
+	if string(data) != `{"x":1.5,"y":2.3}` {
+		t.Errorf("json.Marshal() = %s, want %s", data, `{"x":1.5,"y":2.3}`)
+	}
+}
+
+func TestVector2UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Vector2
+		wantErr bool
+	}{
+		{"lowercase keys", `{"x":1.5,"y":2.3}`, Vector2{X: 1.5, Y: 2.3}, false},
+		{"uppercase keys", `{"X":1.5,"Y":2.3}`, Vector2{X: 1.5, Y: 2.3}, false},
+		{"missing key", `{"x":1.5}`, Vector2{X: 1.5}, false},
+		{"array", `[1.5,2.3]`, Vector2{}, true},
+		{"string component", `{"x":"1.5","y":2.3}`, Vector2{}, true},
+		{"truncated", `{"x":1.5,`, Vector2{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Vector2
+
+			err := json.Unmarshal([]byte(tt.data), &got)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("json.Unmarshal(%s) returned no error", tt.data)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("json.Unmarshal(%s) returned an error: %v", tt.data, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2UnmarshalJSONError(t *testing.T) {
+	var v Vector2
+
+	err := v.UnmarshalJSON([]byte(`[1, 2]`))
+
+	if err == nil || !strings.HasPrefix(err.Error(), "vectors: invalid Vector2 JSON: ") {
+		t.Errorf("UnmarshalJSON() error = %v, want a vectors: invalid Vector2 JSON error", err)
+	}
+}
+
+func FuzzVector2Binary(f *testing.F) {
+	f.Add(0.0, 0.0)
+	f.Add(1.5, -2.3)
+	f.Add(math.Inf(1), math.NaN())
+	f.Add(math.Copysign(0, -1), math.SmallestNonzeroFloat64)
+
+	f.Fuzz(func(t *testing.T, x, y float64) {
+		v := Vector2{X: x, Y: y}
+		data, err := v.MarshalBinary()
+
+		if err != nil {
+			t.Fatalf("MarshalBinary() returned an error: %v", err)
+		}
+
+		if len(data) != 16 {
+			t.Fatalf("MarshalBinary() returned %d bytes, want 16", len(data))
+		}
+
+		var got Vector2
+
+		err = got.UnmarshalBinary(data)
+
+		if err != nil {
+			t.Fatalf("UnmarshalBinary() returned an error: %v", err)
+		}
+
+		if math.Float64bits(got.X) != math.Float64bits(x) || math.Float64bits(got.Y) != math.Float64bits(y) {
+			t.Errorf("binary round trip of %v = %v", v, got)
+		}
+	})
+}
+
+func TestVector2UnmarshalBinaryLength(t *testing.T) {
+	for _, length := range []int{0, 8, 15, 17, 24} {
+		v := Vector2{X: 1, Y: 2}
+
+		err := v.UnmarshalBinary(make([]byte, length))
+
+		if err == nil {
+			t.Errorf("UnmarshalBinary() with %d bytes returned no error", length)
+		}
+
+		if !v.Equal(Vector2{X: 1, Y: 2}) {
+			t.Errorf("UnmarshalBinary() with %d bytes changed the vector to %v", length, v)
+		}
+	}
+}
+
+func TestVector2Abs(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector2
+		want Vector2
+	}{
+		{"all positive", Vector2{X: 1.5, Y: 2}, Vector2{X: 1.5, Y: 2}},
+		{"all negative", Vector2{X: -1.5, Y: -2}, Vector2{X: 1.5, Y: 2}},
+		{"mixed", Vector2{X: -3, Y: 4}, Vector2{X: 3, Y: 4}},
+		{"zero", Vector2{}, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Absed(); !got.Equal(tt.want) {
+				t.Errorf("Absed() = %v, want %v", got, tt.want)
+			}
+
+			got := tt.v
+			got.Abs()
+
+			if !got.Equal(tt.want) {
+				t.Errorf("Abs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2Rounding(t *testing.T) {
+	tests := []struct {
+		in    float64
+		floor float64
+		ceil  float64
+		round float64
+		trunc float64
+	}{
+		{2, 2, 2, 2, 2},
+		{-2, -2, -2, -2, -2},
+		{2.5, 2, 3, 3, 2},
+		{-2.5, -3, -2, -3, -2},
+		{-0.5, -1, 0, -1, 0},
+		{1.2, 1, 2, 1, 1},
+		{-1.7, -2, -1, -2, -1},
+	}
+
+	for _, tt := range tests {
+		v := Vector2{X: tt.in, Y: -tt.in}
+
+		floor := v
+		floor.Floor()
+
+		if want := (Vector2{X: tt.floor, Y: -tt.ceil}); !floor.Equal(want) {
+			t.Errorf("%v.Floor() = %v, want %v", v, floor, want)
+		}
+
+		ceil := v
+		ceil.Ceil()
+
+		if want := (Vector2{X: tt.ceil, Y: -tt.floor}); !ceil.Equal(want) {
+			t.Errorf("%v.Ceil() = %v, want %v", v, ceil, want)
+		}
+
+		round := v
+		round.Round()
+
+		if want := (Vector2{X: tt.round, Y: -tt.round}); !round.Equal(want) {
+			t.Errorf("%v.Round() = %v, want %v", v, round, want)
+		}
+
+		trunc := v
+		trunc.Trunc()
+
+		if want := (Vector2{X: tt.trunc, Y: -tt.trunc}); !trunc.Equal(want) {
+			t.Errorf("%v.Trunc() = %v, want %v", v, trunc, want)
+		}
+	}
+}
+
+func TestVector2ComponentMinMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Vector2
+		b       Vector2
+		wantMin Vector2
+		wantMax Vector2
+	}{
+		{"positive", Vector2{X: 1, Y: 5}, Vector2{X: 3, Y: 2}, Vector2{X: 1, Y: 2}, Vector2{X: 3, Y: 5}},
+		{"negative", Vector2{X: -1, Y: -5}, Vector2{X: -3, Y: -2}, Vector2{X: -3, Y: -5}, Vector2{X: -1, Y: -2}},
+		{"mixed signs", Vector2{X: -1, Y: 5}, Vector2{X: 3, Y: -2}, Vector2{X: -1, Y: -2}, Vector2{X: 3, Y: 5}},
+		{"equal", Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin := tt.a
+			gotMin.ComponentMin(tt.b)
+
+			if !gotMin.Equal(tt.wantMin) {
+				t.Errorf("ComponentMin() = %v, want %v", gotMin, tt.wantMin)
+			}
+
+			gotMax := tt.a
+			gotMax.ComponentMax(tt.b)
+
+			if !gotMax.Equal(tt.wantMax) {
+				t.Errorf("ComponentMax() = %v, want %v", gotMax, tt.wantMax)
+			}
+
+			if got := ComponentMinVector2(tt.a, tt.b); !got.Equal(tt.wantMin) {
+				t.Errorf("ComponentMinVector2() = %v, want %v", got, tt.wantMin)
+			}
+
+			if got := ComponentMaxVector2(tt.a, tt.b); !got.Equal(tt.wantMax) {
+				t.Errorf("ComponentMaxVector2() = %v, want %v", got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestVector2Rotate(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Vector2
+		degrees float64
+		want    Vector2
+	}{
+		{"90 degrees", Vector2{X: 1, Y: 0}, 90, Vector2{X: 0, Y: 1}},
+		{"-90 degrees", Vector2{X: 1, Y: 0}, -90, Vector2{X: 0, Y: -1}},
+		{"45 degrees", Vector2{X: 1, Y: 0}, 45, Vector2{X: math.Sqrt2 / 2, Y: math.Sqrt2 / 2}},
+		{"360 degrees", Vector2{X: 3, Y: -4}, 360, Vector2{X: 3, Y: -4}},
+		{"0 degrees", Vector2{X: 3, Y: -4}, 0, Vector2{X: 3, Y: -4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.RotateDegrees(tt.degrees)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("RotateDegrees(%v) = %v, want %v", tt.degrees, got, tt.want)
+			}
+
+			radians := tt.v
+			radians.Rotate(tt.degrees * math.Pi / 180)
+
+			if !radians.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Rotate(%v) = %v, want %v", tt.degrees*math.Pi/180, radians, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2RotateHalfTurnMatchesBounce(t *testing.T) {
+	v := Vector2{X: 2.5, Y: -1}
+
+	rotated := v
+	rotated.Rotate(math.Pi)
+
+	bounced := v
+	bounced.Bounce()
+
+	if !rotated.ApproxEqual(bounced, testEpsilon) {
+		t.Errorf("Rotate(π) = %v, want %v", rotated, bounced)
+	}
+}
+
+func TestVector2RotateAround(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Vector2
+		pivot   Vector2
+		degrees float64
+		want    Vector2
+	}{
+		{"around the origin", Vector2{X: 2, Y: 1}, Vector2{}, 90, Vector2{X: -1, Y: 2}},
+		{"around an off-center pivot", Vector2{X: 3, Y: 1}, Vector2{X: 1, Y: 1}, 90, Vector2{X: 1, Y: 3}},
+		{"half turn around a pivot", Vector2{X: 3, Y: 1}, Vector2{X: 1, Y: 1}, 180, Vector2{X: -1, Y: 1}},
+		{"point at the pivot", Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: 1}, 45, Vector2{X: 1, Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.RotateAroundDegrees(tt.pivot, tt.degrees)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("RotateAroundDegrees(%v, %v) = %v, want %v", tt.pivot, tt.degrees, got, tt.want)
+			}
+
+			radians := tt.v
+			radians.RotateAround(tt.pivot, tt.degrees*math.Pi/180)
+
+			if !radians.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("RotateAround(%v, %v) = %v, want %v", tt.pivot, tt.degrees*math.Pi/180, radians, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2RotateAroundOriginMatchesRotate(t *testing.T) {
+	v := Vector2{X: 1.5, Y: -0.5}
+
+	around := v
+	around.RotateAround(Vector2{}, math.Pi/2)
+
+	rotated := v
+	rotated.Rotate(math.Pi / 2)
+
+	if !around.ApproxEqual(rotated, testEpsilon) {
+		t.Errorf("RotateAround(origin, π/2) = %v, want %v", around, rotated)
+	}
+}
+
+func TestVector2Perpendicular(t *testing.T) {
+	vecs := []Vector2{
+		{X: 1, Y: 0},
+		{X: 3, Y: 4},
+		{X: -2.5, Y: 0.5},
+	}
+
+	for _, v := range vecs {
+		counterclockwise := v.PerpendicularVector()
+
+		if want := (Vector2{X: -v.Y, Y: v.X}); !counterclockwise.Equal(want) {
+			t.Errorf("%v.PerpendicularVector() = %v, want %v", v, counterclockwise, want)
+		}
+
+		clockwise := v.PerpendicularClockwiseVector()
+
+		if want := (Vector2{X: v.Y, Y: -v.X}); !clockwise.Equal(want) {
+			t.Errorf("%v.PerpendicularClockwiseVector() = %v, want %v", v, clockwise, want)
+		}
+
+		for _, p := range []Vector2{counterclockwise, clockwise} {
+			if !approxEqual(p.Dot(v), 0, testEpsilon) {
+				t.Errorf("perpendicular %v of %v has a dot product of %v", p, v, p.Dot(v))
+			}
+
+			if !approxEqual(p.Magnitude(), v.Magnitude(), testEpsilon) {
+				t.Errorf("perpendicular %v of %v has magnitude %v, want %v", p, v, p.Magnitude(), v.Magnitude())
+			}
+		}
+
+		inPlace := v
+		inPlace.Perpendicular()
+
+		if !inPlace.Equal(counterclockwise) {
+			t.Errorf("%v.Perpendicular() = %v, want %v", v, inPlace, counterclockwise)
+		}
+
+		inPlace = v
+		inPlace.PerpendicularClockwise()
+
+		if !inPlace.Equal(clockwise) {
+			t.Errorf("%v.PerpendicularClockwise() = %v, want %v", v, inPlace, clockwise)
+		}
+	}
+}
+
+func TestVector2MoveTowards(t *testing.T) {
+	start := Vector2{X: 0, Y: 0}
+	target := Vector2{X: 3, Y: 4}
+
+	tests := []struct {
+		name     string
+		maxDelta float64
+		want     Vector2
+	}{
+		{"half the distance", 2.5, Vector2{X: 1.5, Y: 2}},
+		{"exactly the distance", 5, target},
+		{"beyond the distance", 10, target},
+		{"zero step", 0, start},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := start
+			got.MoveTowards(target, tt.maxDelta)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("MoveTowards(%v, %v) = %v, want %v", target, tt.maxDelta, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2SmoothStep(t *testing.T) {
+	start := Vector2{X: 0, Y: 0}
+	target := Vector2{X: 2, Y: 4}
+
+	tests := []struct {
+		name         string
+		t            float64
+		wantSmooth   Vector2
+		wantSmoother Vector2
+	}{
+		{"start", 0, start, start},
+		{"end", 1, target, target},
+		{"midpoint", 0.5, Vector2{X: 1, Y: 2}, Vector2{X: 1, Y: 2}},
+		{"quarter", 0.25, Vector2{X: 0.3125, Y: 0.625}, Vector2{X: 0.20703125, Y: 0.4140625}},
+		{"clamped below", -1, start, start},
+		{"clamped above", 2, target, target},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			smooth := start
+			smooth.SmoothStep(target, tt.t)
+
+			if !smooth.ApproxEqual(tt.wantSmooth, testEpsilon) {
+				t.Errorf("SmoothStep(%v, %v) = %v, want %v", target, tt.t, smooth, tt.wantSmooth)
+			}
+
+			smoother := start
+			smoother.SmootherStep(target, tt.t)
+
+			if !smoother.ApproxEqual(tt.wantSmoother, testEpsilon) {
+				t.Errorf("SmootherStep(%v, %v) = %v, want %v", target, tt.t, smoother, tt.wantSmoother)
+			}
+		})
+	}
+}
+
+func TestVector2SafeDiv(t *testing.T) {
+	tests := []struct {
+		name     string
+		divisor  Vector2
+		want     Vector2
+		wantAxis string
+	}{
+		{"non-zero", Vector2{X: 2, Y: -4}, Vector2{X: 3, Y: -2}, ""},
+		{"zero X", Vector2{X: 0, Y: 2}, Vector2{X: 6, Y: 8}, "X"},
+		{"zero Y", Vector2{X: 2, Y: 0}, Vector2{X: 6, Y: 8}, "Y"},
+		{"zero vector", Vector2{}, Vector2{X: 6, Y: 8}, "X"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Vector2{X: 6, Y: 8}
+			err := got.SafeDiv(tt.divisor)
+
+			if tt.wantAxis == "" {
+				if err != nil {
+					t.Fatalf("SafeDiv(%v) returned an unexpected error: %v", tt.divisor, err)
+				}
+			} else {
+				if !errors.Is(err, ErrDivisionByZero) {
+					t.Fatalf("SafeDiv(%v) error = %v, want %v", tt.divisor, err, ErrDivisionByZero)
+				}
+
+				if !strings.Contains(err.Error(), tt.wantAxis+" axis") {
+					t.Errorf("SafeDiv(%v) error = %q, want it to name the %s axis", tt.divisor, err, tt.wantAxis)
+				}
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("SafeDiv(%v) = %v, want %v", tt.divisor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2ClampMagnitudeRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Vector2
+		minValue  float64
+		maxValue  float64
+		want      Vector2
+		wantPanic bool
+	}{
+		{"below min", Vector2{X: 0.6, Y: 0.8}, 2, 10, Vector2{X: 1.2, Y: 1.6}, false},
+		{"at min", Vector2{X: 1.2, Y: 1.6}, 2, 10, Vector2{X: 1.2, Y: 1.6}, false},
+		{"within range", Vector2{X: 3, Y: 4}, 2, 10, Vector2{X: 3, Y: 4}, false},
+		{"at max", Vector2{X: 6, Y: 8}, 2, 10, Vector2{X: 6, Y: 8}, false},
+		{"above max", Vector2{X: 30, Y: 40}, 2, 10, Vector2{X: 6, Y: 8}, false},
+		{"zero vector", Vector2{}, 2, 10, Vector2{}, false},
+		{"min greater than max", Vector2{X: 3, Y: 0}, 5, 2, Vector2{}, true},
+		{"zero vector with min greater than max", Vector2{}, 5, 2, Vector2{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("%v.ClampMagnitudeRange(%v, %v) panicked = %v, want %v", tt.input, tt.minValue, tt.maxValue, r != nil, tt.wantPanic)
+				}
+			}()
+
+			got := tt.input
+			got.ClampMagnitudeRange(tt.minValue, tt.maxValue)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ClampMagnitudeRange(%v, %v) = %v, want %v", tt.input, tt.minValue, tt.maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2Cross(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Vector2
+		b    Vector2
+		want float64
+	}{
+		{"counterclockwise", Vector2{X: 1, Y: 0}, Vector2{X: 0, Y: 1}, 1},
+		{"clockwise", Vector2{X: 0, Y: 1}, Vector2{X: 1, Y: 0}, -1},
+		{"collinear", Vector2{X: 1, Y: 2}, Vector2{X: -2, Y: -4}, 0},
+		{"general", Vector2{X: 3, Y: 4}, Vector2{X: 5, Y: -2}, -26},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Cross(tt.b); got != tt.want {
+				t.Errorf("%v.Cross(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := tt.b.Cross(tt.a); got != -tt.want {
+				t.Errorf("%v.Cross(%v) = %v, want %v", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2FloatChecks(t *testing.T) {
+	nan := math.NaN()
+	inf := math.Inf(1)
+
+	tests := []struct {
+		name       string
+		input      Vector2
+		wantNaN    bool
+		wantInf    bool
+		wantFinite bool
+	}{
+		{"finite", Vector2{X: 1, Y: -2}, false, false, true},
+		{"one NaN", Vector2{X: nan, Y: 0}, true, false, false},
+		{"all NaN", Vector2{X: nan, Y: nan}, true, false, false},
+		{"one infinity", Vector2{X: 0, Y: -inf}, false, true, false},
+		{"all infinity", Vector2{X: inf, Y: -inf}, false, true, false},
+		{"NaN and infinity", Vector2{X: nan, Y: inf}, true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.IsNaN(); got != tt.wantNaN {
+				t.Errorf("%v.IsNaN() = %v, want %v", tt.input, got, tt.wantNaN)
+			}
+
+			if got := tt.input.IsInf(); got != tt.wantInf {
+				t.Errorf("%v.IsInf() = %v, want %v", tt.input, got, tt.wantInf)
+			}
+
+			if got := tt.input.IsFinite(); got != tt.wantFinite {
+				t.Errorf("%v.IsFinite() = %v, want %v", tt.input, got, tt.wantFinite)
+			}
+
+			if tt.input.IsFinite() != (!tt.input.IsNaN() && !tt.input.IsInf()) {
+				t.Errorf("%v.IsFinite() disagrees with IsNaN() and IsInf()", tt.input)
+			}
+		})
+	}
+}
+
+func TestVector2SetMagnitude(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2
+	}{
+		{"shorter", Vector2{X: 0.3, Y: 0.4}, Vector2{X: 3, Y: 4}},
+		{"longer", Vector2{X: -30, Y: 40}, Vector2{X: -3, Y: 4}},
+		{"axis aligned", Vector2{X: 0, Y: -7}, Vector2{X: 0, Y: -5}},
+		{"zero vector", Vector2{}, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.SetMagnitude(5)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SetMagnitude(5) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			if !tt.input.IsZero() && !approxEqual(got.Magnitude(), 5, testEpsilon) {
+				t.Errorf("%v.SetMagnitude(5) has magnitude %v, want 5", tt.input, got.Magnitude())
+			}
+		})
+	}
+}
+
+func TestVector2IsNormalized(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Vector2
+		want  bool
+	}{
+		{"unit X", Vector2{X: 1, Y: 0}, true},
+		{"unit diagonal", Vector2{X: 0.6, Y: -0.8}, true},
+		{"small drift", Vector2{X: 1 + 1e-12, Y: 0}, true},
+		{"large drift", Vector2{X: 1 + 1e-6, Y: 0}, false},
+		{"not normalized", Vector2{X: 3, Y: 4}, false},
+		{"zero vector", Vector2{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.IsNormalized(); got != tt.want {
+				t.Errorf("%v.IsNormalized() = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2IsParallelAndPerpendicular(t *testing.T) {
+	tests := []struct {
+		name              string
+		a                 Vector2
+		b                 Vector2
+		wantParallel      bool
+		wantPerpendicular bool
+	}{
+		{"same direction", Vector2{X: 1, Y: 2}, Vector2{X: 2, Y: 4}, true, false},
+		{"opposite direction", Vector2{X: 1, Y: 2}, Vector2{X: -3, Y: -6}, true, false},
+		{"nearly parallel", Vector2{X: 1, Y: 0}, Vector2{X: 1, Y: 1e-12}, true, false},
+		{"perpendicular", Vector2{X: 1, Y: 2}, Vector2{X: -2, Y: 1}, false, true},
+		{"nearly perpendicular", Vector2{X: 1, Y: 0}, Vector2{X: 1e-12, Y: 1}, false, true},
+		{"neither", Vector2{X: 1, Y: 0}, Vector2{X: 1, Y: 1}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsParallel(tt.b, testEpsilon); got != tt.wantParallel {
+				t.Errorf("%v.IsParallel(%v) = %v, want %v", tt.a, tt.b, got, tt.wantParallel)
+			}
+
+			if got := tt.a.IsPerpendicular(tt.b, testEpsilon); got != tt.wantPerpendicular {
+				t.Errorf("%v.IsPerpendicular(%v) = %v, want %v", tt.a, tt.b, got, tt.wantPerpendicular)
+			}
+		})
+	}
+}
+
+func TestVector2SnapToGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Vector2
+		gridSize float64
+		want     Vector2
+	}{
+		{"positive", Vector2{X: 7, Y: 12}, 5, Vector2{X: 5, Y: 10}},
+		{"negative", Vector2{X: -7, Y: -13}, 5, Vector2{X: -5, Y: -15}},
+		{"fractional", Vector2{X: 0.3, Y: 0.74}, 0.25, Vector2{X: 0.25, Y: 0.75}},
+		{"grid of one", Vector2{X: 1.4, Y: -1.6}, 1, Vector2{X: 1, Y: -2}},
+		{"already snapped", Vector2{X: 10, Y: -20}, 10, Vector2{X: 10, Y: -20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.SnapToGrid(tt.gridSize)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SnapToGrid(%v) = %v, want %v", tt.input, tt.gridSize, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2SnapToGridXY(t *testing.T) {
+	got := Vector2{X: 7, Y: 7}
+	got.SnapToGridXY(5, 2)
+
+	if want := (Vector2{X: 5, Y: 8}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("SnapToGridXY(5, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestVector2SnapToGridZeroPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("SnapToGrid(0) did not panic")
+		}
+	}()
+
+	v := Vector2{X: 1, Y: 1}
+	v.SnapToGrid(0)
+}
+
+func TestVector2SafeNormalize(t *testing.T) {
+	fallback := Vector2{X: 0, Y: 1}
+
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2
+	}{
+		{"non-zero", Vector2{X: 3, Y: 4}, Vector2{X: 0.6, Y: 0.8}},
+		{"already normalized", Vector2{X: -1, Y: 0}, Vector2{X: -1, Y: 0}},
+		{"zero vector", Vector2{}, fallback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.SafeNormalize(fallback)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SafeNormalize(%v) = %v, want %v", tt.input, fallback, got, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzVector2Text(f *testing.F) {
+	f.Add(0.0, 0.0)
+	f.Add(1.5, -2.3)
+	f.Add(math.NaN(), 1.0)
+	f.Add(math.Inf(1), math.Inf(-1))
+	f.Add(math.Copysign(0, -1), math.MaxFloat64)
+	f.Add(math.SmallestNonzeroFloat64, 1e21)
+
+	f.Fuzz(func(t *testing.T, x, y float64) {
+		v := Vector2{X: x, Y: y}
+		data, err := v.MarshalText()
+
+		if err != nil {
+			t.Fatalf("MarshalText() returned an error: %v", err)
+		}
+
+		var got Vector2
+
+		err = got.UnmarshalText(data)
+
+		if err != nil {
+			t.Fatalf("UnmarshalText(%q) returned an error: %v", data, err)
+		}
+
+		for _, axis := range [][2]float64{{got.X, x}, {got.Y, y}} {
+			if math.IsNaN(axis[1]) {
+				if !math.IsNaN(axis[0]) {
+					t.Errorf("text round trip of %v through %q = %v", v, data, got)
+				}
+
+				continue
+			}
+
+			if math.Float64bits(axis[0]) != math.Float64bits(axis[1]) {
+				t.Errorf("text round trip of %v through %q = %v", v, data, got)
+			}
+		}
+	})
+}
+
+func TestVector2ArrayConversion(t *testing.T) {
+	v := Vector2{X: 1.5, Y: -2}
+
+	arr := v.ToArray()
+
+	if arr != [2]float64{1.5, -2} {
+		t.Errorf("ToArray() = %v, want [1.5 -2]", arr)
+	}
+
+	if got := Vector2FromArray(arr); !got.Equal(v) {
+		t.Errorf("Vector2FromArray(%v) = %v, want %v", arr, got, v)
+	}
+
+	slice := v.ToSlice()
+
+	if len(slice) != 2 || slice[0] != v.X || slice[1] != v.Y {
+		t.Errorf("ToSlice() = %v, want [%v %v]", slice, v.X, v.Y)
+	}
+
+	arr[0] = 100
+	slice[1] = 100
+
+	if want := (Vector2{X: 1.5, Y: -2}); !v.Equal(want) {
+		t.Errorf("modifying the returned array or slice changed the vector to %v", v)
+	}
+}
+
+func TestParseVector2(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Vector2
+	}{
+		{"bare", "1.5,2.3", Vector2{X: 1.5, Y: 2.3}},
+		{"parentheses", "(1.5, 2.3)", Vector2{X: 1.5, Y: 2.3}},
+		{"brackets", "[-1, 0]", Vector2{X: -1, Y: 0}},
+		{"whitespace", "  ( 4 ,  -5e2 )  ", Vector2{X: 4, Y: -500}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVector2(tt.input)
+
+			if err != nil {
+				t.Fatalf("ParseVector2(%q) returned an error: %v", tt.input, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseVector2(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVector2Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantText string
+	}{
+		{"empty", "", "expected 2 components"},
+		{"too few components", "(1)", "expected 2 components"},
+		{"too many components", "1,2,3", "expected 2 components"},
+		{"invalid X", "(a, 2)", "X component"},
+		{"invalid Y", "[1, b]", "Y component"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseVector2(tt.input)
+
+			if err == nil {
+				t.Fatalf("ParseVector2(%q) returned no error", tt.input)
+			}
+
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Errorf("ParseVector2(%q) error = %q, want it to contain %q", tt.input, err, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestParseVector2StringRoundTrip(t *testing.T) {
+	for _, v := range []Vector2{{X: 0, Y: 0}, {X: 1, Y: -2}, {X: 0.1, Y: 1e21}, {X: -3.75, Y: 5e-324}} {
+		got, err := ParseVector2(v.String())
+
+		if err != nil {
+			t.Fatalf("ParseVector2(%q) returned an error: %v", v.String(), err)
+		}
+
+		if !got.Equal(v) {
+			t.Errorf("ParseVector2(%q) = %v, want %v", v.String(), got, v)
+		}
+	}
+}
+
+func TestSumAndAverageVector2(t *testing.T) {
+	tests := []struct {
+		name        string
+		vecs        []Vector2
+		wantSum     Vector2
+		wantAverage Vector2
+	}{
+		{"empty", nil, Vector2{}, Vector2{}},
+		{"single", []Vector2{{X: 3, Y: -1}}, Vector2{X: 3, Y: -1}, Vector2{X: 3, Y: -1}},
+		{"even count", []Vector2{{X: 1, Y: 2}, {X: 3, Y: 4}}, Vector2{X: 4, Y: 6}, Vector2{X: 2, Y: 3}},
+		{"odd count", []Vector2{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: 6}}, Vector2{X: 3, Y: 6}, Vector2{X: 1, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SumVector2(tt.vecs); !got.ApproxEqual(tt.wantSum, testEpsilon) {
+				t.Errorf("SumVector2(%v) = %v, want %v", tt.vecs, got, tt.wantSum)
+			}
+
+			if got := AverageVector2(tt.vecs); !got.ApproxEqual(tt.wantAverage, testEpsilon) {
+				t.Errorf("AverageVector2(%v) = %v, want %v", tt.vecs, got, tt.wantAverage)
+			}
+		})
+	}
+}
+
+func TestLookAt2D(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to Vector2
+		want     Vector2
+	}{
+		{"along X", Vector2{X: 1, Y: 1}, Vector2{X: 5, Y: 1}, Vector2{X: 1, Y: 0}},
+		{"diagonal", Vector2{}, Vector2{X: -3, Y: 4}, Vector2{X: -0.6, Y: 0.8}},
+		{"same point", Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LookAt2D(tt.from, tt.to); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("LookAt2D(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func testVec2Instantiation[T Float](t *testing.T, wantTypeName string) {
+	v := NewVec2[T](3, 4)
+
+	if got := v.Magnitude(); got != 5 {
+		t.Errorf("%v.Magnitude() = %v, want 5", v, got)
+	}
+
+	normalized := v.Normalized()
+
+	if !normalized.IsNormalized() {
+		t.Errorf("%v.Normalized() = %v, which is not normalized", v, normalized)
+	}
+
+	if got, want := v.Added(NewVec2[T](1, -1)), NewVec2[T](4, 3); !got.Equal(want) {
+		t.Errorf("%v.Added() = %v, want %v", v, got, want)
+	}
+
+	if got := v.String(); got != "(3.0, 4.0)" {
+		t.Errorf("%v.String() = %q, want %q", v, got, "(3.0, 4.0)")
+	}
+
+	if got, want := v.GoString(), "vectors."+wantTypeName+"{"; !strings.HasPrefix(got, want) {
+		t.Errorf("%v.GoString() = %q, want it to start with %q", v, got, want)
+	}
+
+	if got := Vec2FromVector2[T](Vector2FromVec2(v)); !got.Equal(v) {
+		t.Errorf("Vector2 conversion round trip of %v = %v", v, got)
+	}
+
+	data, err := v.MarshalText()
+
+	if err != nil {
+		t.Fatalf("MarshalText() returned an error: %v", err)
+	}
+
+	var decoded Vec2[T]
+
+	if err := decoded.UnmarshalText(data); err != nil || !decoded.Equal(v) {
+		t.Errorf("text round trip of %v = %v, %v", v, decoded, err)
+	}
+
+	err = decoded.UnmarshalText([]byte("1"))
+
+	if err == nil || !strings.Contains(err.Error(), wantTypeName) {
+		t.Errorf("UnmarshalText() error = %v, want it to name %s", err, wantTypeName)
+	}
+}
+
+func TestVec2Instantiations(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		testVec2Instantiation[float32](t, "Vector2f32")
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		testVec2Instantiation[float64](t, "Vector2")
+	})
+}
+
+func TestVector2DistanceMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          Vector2
+		wantManhattan float64
+		wantChebyshev float64
+	}{
+		{"same point", Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: 1}, 0, 0},
+		{"axis aligned", Vector2{}, Vector2{X: -4, Y: 0}, 4, 4},
+		{"diagonal", Vector2{X: 1, Y: 2}, Vector2{X: 4, Y: -2}, 7, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.ManhattanDistance(tt.b); got != tt.wantManhattan {
+				t.Errorf("%v.ManhattanDistance(%v) = %v, want %v", tt.a, tt.b, got, tt.wantManhattan)
+			}
+
+			if got := tt.a.ChebyshevDistance(tt.b); got != tt.wantChebyshev {
+				t.Errorf("%v.ChebyshevDistance(%v) = %v, want %v", tt.a, tt.b, got, tt.wantChebyshev)
+			}
+
+			if got := tt.a.MinkowskiDistance(tt.b, 1); !approxEqual(got, tt.wantManhattan, testEpsilon) {
+				t.Errorf("%v.MinkowskiDistance(%v, 1) = %v, want %v", tt.a, tt.b, got, tt.wantManhattan)
+			}
+
+			if got, want := tt.a.MinkowskiDistance(tt.b, 2), tt.a.Distance(tt.b); !approxEqual(got, want, testEpsilon) {
+				t.Errorf("%v.MinkowskiDistance(%v, 2) = %v, want %v", tt.a, tt.b, got, want)
+			}
+
+			if got := tt.b.ManhattanDistance(tt.a); got != tt.wantManhattan {
+				t.Errorf("ManhattanDistance is not symmetric for %v and %v", tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestVector2CosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vector2
+		want float64
+	}{
+		{"same direction", Vector2{X: 1, Y: 2}, Vector2{X: 3, Y: 6}, 1},
+		{"opposite direction", Vector2{X: 1, Y: 2}, Vector2{X: -2, Y: -4}, -1},
+		{"perpendicular", Vector2{X: 1, Y: 0}, Vector2{X: 0, Y: 5}, 0},
+		{"45 degrees", Vector2{X: 1, Y: 0}, Vector2{X: 2, Y: 2}, math.Sqrt2 / 2},
+		{"zero vector", Vector2{}, Vector2{X: 1, Y: 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.CosineSimilarity(tt.b); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("%v.CosineSimilarity(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2LerpClampedAndUnclamped(t *testing.T) {
+	start := Vector2{X: 0, Y: 0}
+	target := Vector2{X: 2, Y: -4}
+
+	tests := []struct {
+		name          string
+		t             float64
+		wantUnclamped Vector2
+		wantClamped   Vector2
+	}{
+		{"start", 0, start, start},
+		{"midpoint", 0.5, Vector2{X: 1, Y: -2}, Vector2{X: 1, Y: -2}},
+		{"end", 1, target, target},
+		{"before start", -0.5, Vector2{X: -1, Y: 2}, start},
+		{"past end", 1.5, Vector2{X: 3, Y: -6}, target},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unclamped := start
+			unclamped.LerpUnclamped(target, tt.t)
+
+			if !unclamped.ApproxEqual(tt.wantUnclamped, testEpsilon) {
+				t.Errorf("LerpUnclamped(%v, %v) = %v, want %v", target, tt.t, unclamped, tt.wantUnclamped)
+			}
+
+			clamped := start
+			clamped.LerpClamped(target, tt.t)
+
+			if !clamped.ApproxEqual(tt.wantClamped, testEpsilon) {
+				t.Errorf("LerpClamped(%v, %v) = %v, want %v", target, tt.t, clamped, tt.wantClamped)
+			}
+		})
+	}
+}
+
+func TestHermiteVector2(t *testing.T) {
+	p0 := Vector2{X: 0, Y: 0}
+	p1 := Vector2{X: 4, Y: 2}
+	t0 := Vector2{X: 1, Y: 3}
+	t1 := Vector2{X: -2, Y: 1}
+
+	if got := HermiteVector2(p0, p1, t0, t1, 0); !got.ApproxEqual(p0, testEpsilon) {
+		t.Errorf("HermiteVector2() at t=0 = %v, want %v", got, p0)
+	}
+
+	if got := HermiteVector2(p0, p1, t0, t1, 1); !got.ApproxEqual(p1, testEpsilon) {
+		t.Errorf("HermiteVector2() at t=1 = %v, want %v", got, p1)
+	}
+
+	const h = 1e-6
+
+	start := HermiteVector2(p0, p1, t0, t1, h).Subbed(HermiteVector2(p0, p1, t0, t1, 0)).Scaled(1 / h)
+
+	if !start.ApproxEqual(t0, 1e-4) {
+		t.Errorf("HermiteVector2() derivative at t=0 = %v, want %v", start, t0)
+	}
+
+	end := HermiteVector2(p0, p1, t0, t1, 1).Subbed(HermiteVector2(p0, p1, t0, t1, 1-h)).Scaled(1 / h)
+
+	if !end.ApproxEqual(t1, 1e-4) {
+		t.Errorf("HermiteVector2() derivative at t=1 = %v, want %v", end, t1)
+	}
+}
+
+func TestCatmullRomVector2(t *testing.T) {
+	p0 := Vector2{X: -1, Y: 1}
+	p1 := Vector2{X: 0, Y: 0}
+	p2 := Vector2{X: 2, Y: 1}
+	p3 := Vector2{X: 3, Y: 3}
+
+	if got := CatmullRomVector2(p0, p1, p2, p3, 0); !got.ApproxEqual(p1, testEpsilon) {
+		t.Errorf("CatmullRomVector2() at t=0 = %v, want %v", got, p1)
+	}
+
+	if got := CatmullRomVector2(p0, p1, p2, p3, 1); !got.ApproxEqual(p2, testEpsilon) {
+		t.Errorf("CatmullRomVector2() at t=1 = %v, want %v", got, p2)
+	}
+
+	collinear := CatmullRomVector2(Vector2{X: 0}, Vector2{X: 1}, Vector2{X: 2}, Vector2{X: 3}, 0.25)
+
+	if want := (Vector2{X: 1.25}); !collinear.ApproxEqual(want, testEpsilon) {
+		t.Errorf("CatmullRomVector2() on evenly spaced collinear points = %v, want %v", collinear, want)
+	}
+}
+
+func TestCatmullRomChain2(t *testing.T) {
+	points := []Vector2{{X: 0, Y: 0}, {X: 1, Y: 2}, {X: 3, Y: 1}, {X: 4, Y: 4}}
+
+	for i, point := range points {
+		at := float64(i) / float64(len(points)-1)
+
+		if got := CatmullRomChain2(points, at); !got.ApproxEqual(point, testEpsilon) {
+			t.Errorf("CatmullRomChain2() at t=%v = %v, want %v", at, got, point)
+		}
+	}
+
+	if got := CatmullRomChain2(points, -1); !got.ApproxEqual(points[0], testEpsilon) {
+		t.Errorf("CatmullRomChain2() at t=-1 = %v, want %v", got, points[0])
+	}
+
+	if got := CatmullRomChain2(points, 2); !got.ApproxEqual(points[3], testEpsilon) {
+		t.Errorf("CatmullRomChain2() at t=2 = %v, want %v", got, points[3])
+	}
+
+	const h = 1e-7
+
+	for i := 1; i < len(points)-1; i++ {
+		joint := float64(i) / float64(len(points)-1)
+		before := CatmullRomChain2(points, joint).Subbed(CatmullRomChain2(points, joint-h)).Scaled(1 / h)
+		after := CatmullRomChain2(points, joint+h).Subbed(CatmullRomChain2(points, joint)).Scaled(1 / h)
+
+		if !before.ApproxEqual(after, 1e-4) {
+			t.Errorf("CatmullRomChain2() derivative at point %d is %v before and %v after", i, before, after)
+		}
+	}
+
+	if got := CatmullRomChain2(nil, 0.5); !got.IsZero() {
+		t.Errorf("CatmullRomChain2(nil) = %v, want the zero vector", got)
+	}
+
+	if got := CatmullRomChain2(points[:1], 0.5); !got.Equal(points[0]) {
+		t.Errorf("CatmullRomChain2() with one point = %v, want %v", got, points[0])
+	}
+}
+
+func TestVector2Wrap(t *testing.T) {
+	minValue := Vector2{X: 0, Y: -5}
+	maxValue := Vector2{X: 10, Y: 5}
+
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2
+	}{
+		{"inside", Vector2{X: 3, Y: 2}, Vector2{X: 3, Y: 2}},
+		{"at min", Vector2{X: 0, Y: -5}, Vector2{X: 0, Y: -5}},
+		{"at max", Vector2{X: 10, Y: 5}, Vector2{X: 0, Y: -5}},
+		{"above max", Vector2{X: 12, Y: 7}, Vector2{X: 2, Y: -3}},
+		{"below min", Vector2{X: -3, Y: -8}, Vector2{X: 7, Y: 2}},
+		{"multiple wraps", Vector2{X: 35, Y: -26}, Vector2{X: 5, Y: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.Wrap(minValue, maxValue)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.Wrap(%v, %v) = %v, want %v", tt.input, minValue, maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2WrapScalar(t *testing.T) {
+	got := Vector2{X: -1, Y: 4.5}
+	got.WrapScalar(0, 4)
+
+	if want := (Vector2{X: 3, Y: 0.5}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("WrapScalar(0, 4) = %v, want %v", got, want)
+	}
+
+	empty := Vector2{X: 7, Y: -7}
+	empty.WrapScalar(2, 2)
+
+	if want := (Vector2{X: 2, Y: 2}); !empty.Equal(want) {
+		t.Errorf("WrapScalar(2, 2) = %v, want %v", empty, want)
+	}
+}
+
+func TestVector2ToroidalDistance(t *testing.T) {
+	size := Vector2{X: 10, Y: 10}
+
+	tests := []struct {
+		name string
+		a, b Vector2
+		want float64
+	}{
+		{"direct", Vector2{X: 1, Y: 1}, Vector2{X: 4, Y: 5}, 5},
+		{"across the X edge", Vector2{X: 1, Y: 0}, Vector2{X: 9, Y: 0}, 2},
+		{"across both edges", Vector2{X: 9, Y: 9}, Vector2{X: 1, Y: 1}, math.Sqrt(8)},
+		{"half the size", Vector2{X: 0, Y: 0}, Vector2{X: 5, Y: 0}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.ToroidalDistance(tt.b, size); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("%v.ToroidalDistance(%v, %v) = %v, want %v", tt.a, tt.b, size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2ClosestPointOnSegment(t *testing.T) {
+	a := Vector2{X: 0, Y: 0}
+	b := Vector2{X: 4, Y: 0}
+
+	tests := []struct {
+		name         string
+		point        Vector2
+		want         Vector2
+		wantDistance float64
+	}{
+		{"above the middle", Vector2{X: 2, Y: 3}, Vector2{X: 2, Y: 0}, 3},
+		{"on the segment", Vector2{X: 1, Y: 0}, Vector2{X: 1, Y: 0}, 0},
+		{"before a", Vector2{X: -3, Y: 4}, a, 5},
+		{"past b", Vector2{X: 7, Y: -4}, b, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.ClosestPointOnSegment(a, b); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ClosestPointOnSegment(%v, %v) = %v, want %v", tt.point, a, b, got, tt.want)
+			}
+
+			if got := tt.point.DistanceToSegment(a, b); !approxEqual(got, tt.wantDistance, testEpsilon) {
+				t.Errorf("%v.DistanceToSegment(%v, %v) = %v, want %v", tt.point, a, b, got, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestVector2ClosestPointOnDegenerateSegment(t *testing.T) {
+	a := Vector2{X: 1, Y: 1}
+	point := Vector2{X: 4, Y: 5}
+
+	if got := point.ClosestPointOnSegment(a, a); !got.Equal(a) {
+		t.Errorf("%v.ClosestPointOnSegment(%v, %v) = %v, want %v", point, a, a, got, a)
+	}
+
+	if got := point.DistanceToSegment(a, a); !approxEqual(got, 5, testEpsilon) {
+		t.Errorf("%v.DistanceToSegment(%v, %v) = %v, want 5", point, a, a, got)
+	}
+}
+
+func TestVector2MapAndZip(t *testing.T) {
+	v := Vector2{X: -1.5, Y: 4}
+
+	if got, want := v.Map(math.Abs), (Vector2{X: 1.5, Y: 4}); !got.Equal(want) {
+		t.Errorf("%v.Map(math.Abs) = %v, want %v", v, got, want)
+	}
+
+	if got, want := v.Map(func(x float64) float64 { return x * 2 }), (Vector2{X: -3, Y: 8}); !got.Equal(want) {
+		t.Errorf("%v.Map(double) = %v, want %v", v, got, want)
+	}
+
+	if want := (Vector2{X: -1.5, Y: 4}); !v.Equal(want) {
+		t.Errorf("Map() modified the receiver to %v", v)
+	}
+
+	other := Vector2{X: 2, Y: -3}
+
+	if got, want := ZipVector2(v, other, math.Max), (Vector2{X: 2, Y: 4}); !got.Equal(want) {
+		t.Errorf("ZipVector2(%v, %v, math.Max) = %v, want %v", v, other, got, want)
+	}
+
+	if got, want := ZipVector2(v, other, math.Min), ComponentMinVector2(v, other); !got.Equal(want) {
+		t.Errorf("ZipVector2(%v, %v, math.Min) = %v, want %v", v, other, got, want)
+	}
+}
+
+func TestVector2SaturateAndFract(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         Vector2
+		wantSaturated Vector2
+		wantFract     Vector2
+	}{
+		{"in range", Vector2{X: 0.25, Y: 0.75}, Vector2{X: 0.25, Y: 0.75}, Vector2{X: 0.25, Y: 0.75}},
+		{"above one", Vector2{X: 1.5, Y: 3}, Vector2{X: 1, Y: 1}, Vector2{X: 0.5, Y: 0}},
+		{"negative", Vector2{X: -0.25, Y: -2.5}, Vector2{X: 0, Y: 0}, Vector2{X: 0.75, Y: 0.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Saturated(); !got.ApproxEqual(tt.wantSaturated, testEpsilon) {
+				t.Errorf("%v.Saturated() = %v, want %v", tt.input, got, tt.wantSaturated)
+			}
+
+			if got := tt.input.Fracted(); !got.ApproxEqual(tt.wantFract, testEpsilon) {
+				t.Errorf("%v.Fracted() = %v, want %v", tt.input, got, tt.wantFract)
+			}
+
+			saturated := tt.input
+			saturated.Saturate()
+
+			if !saturated.Equal(tt.input.Saturated()) {
+				t.Errorf("%v.Saturate() = %v, want %v", tt.input, saturated, tt.input.Saturated())
+			}
+
+			fract := tt.input
+			fract.Fract()
+
+			if !fract.Equal(tt.input.Fracted()) {
+				t.Errorf("%v.Fract() = %v, want %v", tt.input, fract, tt.input.Fracted())
+			}
+		})
+	}
+}
+
+func TestVector2StepComponent(t *testing.T) {
+	edge := Vector2{X: 0.5, Y: -1}
+
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2
+	}{
+		{"below both", Vector2{X: 0, Y: -2}, Vector2{X: 0, Y: 0}},
+		{"at both", Vector2{X: 0.5, Y: -1}, Vector2{X: 1, Y: 1}},
+		{"mixed", Vector2{X: 1, Y: -3}, Vector2{X: 1, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Step(edge); !got.Equal(tt.want) {
+				t.Errorf("%v.Step(%v) = %v, want %v", tt.input, edge, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2SmoothStepComponent(t *testing.T) {
+	edge0 := Vector2{X: 0, Y: 2}
+	edge1 := Vector2{X: 1, Y: 2}
+
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2
+	}{
+		{"at edge0", Vector2{X: 0, Y: 1}, Vector2{X: 0, Y: 0}},
+		{"between edges", Vector2{X: 0.25, Y: 3}, Vector2{X: 0.15625, Y: 1}},
+		{"midpoint", Vector2{X: 0.5, Y: 2}, Vector2{X: 0.5, Y: 1}},
+		{"beyond edge1", Vector2{X: 5, Y: -5}, Vector2{X: 1, Y: 0}},
+		{"below edge0", Vector2{X: -5, Y: 2}, Vector2{X: 0, Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.SmoothStepComponent(edge0, edge1); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SmoothStepComponent(%v, %v) = %v, want %v", tt.input, edge0, edge1, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2Quantize(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Vector2
+		precision int
+		want      Vector2
+	}{
+		{"two decimals", Vector2{X: 1.23456, Y: -9.87654}, 2, Vector2{X: 1.23, Y: -9.88}},
+		{"integers", Vector2{X: 1.5, Y: -2.4}, 0, Vector2{X: 2, Y: -2}},
+		{"tens", Vector2{X: 123, Y: -157}, -1, Vector2{X: 120, Y: -160}},
+		{"hundreds", Vector2{X: 149, Y: -151}, -2, Vector2{X: 100, Y: -200}},
+		{"precision overflows", Vector2{X: 1.5, Y: 2}, 400, Vector2{X: 1.5, Y: 2}},
+		{"precision underflows", Vector2{X: 1.5, Y: 2}, -400, Vector2{X: 1.5, Y: 2}},
+		{"scaled axis overflows", Vector2{X: 1e307, Y: 1.234}, 2, Vector2{X: 1e307, Y: 1.23}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.Quantize(tt.precision)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.Quantize(%d) = %v, want %v", tt.input, tt.precision, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2f32QuantizeOverflow(t *testing.T) {
+	got := Vector2f32{X: 1.5, Y: -2.25}
+	got.Quantize(39)
+
+	if want := (Vector2f32{X: 1.5, Y: -2.25}); got != want {
+		t.Errorf("Vector2f32.Quantize(39) = %v, want %v", got, want)
+	}
+}
+
+func TestVector2QuantizeToStep(t *testing.T) {
+	got := Vector2{X: 0.3, Y: -0.9}
+	got.QuantizeToStep(0.25)
+
+	if want := (Vector2{X: 0.25, Y: -1}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("QuantizeToStep(0.25) = %v, want %v", got, want)
+	}
+}
+
+func TestSpringVector2(t *testing.T) {
+	current := Vector2{X: 0, Y: 0}
+	target := Vector2{X: 10, Y: -5}
+	velocity := Vector2{}
+
+	SpringVector2(&current, &target, &velocity, 0.5, 0)
+
+	if !current.IsZero() || !velocity.IsZero() {
+		t.Errorf("SpringVector2() with a deltaTime of 0 moved to %v with velocity %v", current, velocity)
+	}
+
+	previousDistance := current.Distance(target)
+
+	for range 300 {
+		SpringVector2(&current, &target, &velocity, 0.5, 1.0/60)
+
+		distance := current.Distance(target)
+
+		if distance > previousDistance+testEpsilon {
+			t.Fatalf("SpringVector2() moved away from the target, from a distance of %v to %v", previousDistance, distance)
+		}
+
+		if current.Subbed(target).Dot(Vector2{X: -10, Y: 5}) < -testEpsilon {
+			t.Fatalf("SpringVector2() overshot the target to %v", current)
+		}
+
+		previousDistance = distance
+	}
+
+	if !current.ApproxEqual(target, 1e-3) {
+		t.Errorf("SpringVector2() after 5 seconds = %v, want %v", current, target)
+	}
+
+	if velocity.Magnitude() > 1e-2 {
+		t.Errorf("SpringVector2() after 5 seconds has velocity %v, want roughly zero", velocity)
+	}
+}
+
+func TestBezier2(t *testing.T) {
+	p0 := Vector2{X: 0, Y: 0}
+	p1 := Vector2{X: 1, Y: 2}
+	p2 := Vector2{X: 3, Y: 2}
+	p3 := Vector2{X: 4, Y: 0}
+
+	tests := []struct {
+		name string
+		got  Vector2
+		want Vector2
+	}{
+		{"quadratic start", QuadraticBezier2(p0, p1, p2, 0), p0},
+		{"quadratic end", QuadraticBezier2(p0, p1, p2, 1), p2},
+		{"quadratic midpoint", QuadraticBezier2(p0, p1, p2, 0.5), Vector2{X: 1.25, Y: 1.5}},
+		{"quadratic start tangent", QuadraticBezierTangent2(p0, p1, p2, 0), Vector2{X: 2, Y: 4}},
+		{"quadratic end tangent", QuadraticBezierTangent2(p0, p1, p2, 1), Vector2{X: 4, Y: 0}},
+		{"cubic start", CubicBezier2(p0, p1, p2, p3, 0), p0},
+		{"cubic end", CubicBezier2(p0, p1, p2, p3, 1), p3},
+		{"cubic midpoint", CubicBezier2(p0, p1, p2, p3, 0.5), Vector2{X: 2, Y: 1.5}},
+		{"cubic start tangent", CubicBezierTangent2(p0, p1, p2, p3, 0), Vector2{X: 3, Y: 6}},
+		{"cubic end tangent", CubicBezierTangent2(p0, p1, p2, p3, 1), Vector2{X: 3, Y: -6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBezierTangent2MatchesDerivative(t *testing.T) {
+	p0 := Vector2{X: 0, Y: 0}
+	p1 := Vector2{X: -1, Y: 3}
+	p2 := Vector2{X: 5, Y: 1}
+	p3 := Vector2{X: 2, Y: -2}
+
+	const h = 1e-6
+
+	for _, at := range []float64{0.1, 0.4, 0.75} {
+		quadratic := QuadraticBezier2(p0, p1, p2, at+h).Subbed(QuadraticBezier2(p0, p1, p2, at-h)).Scaled(1 / (2 * h))
+
+		if got := QuadraticBezierTangent2(p0, p1, p2, at); !got.ApproxEqual(quadratic, 1e-6) {
+			t.Errorf("QuadraticBezierTangent2() at t=%v = %v, want %v", at, got, quadratic)
+		}
+
+		cubic := CubicBezier2(p0, p1, p2, p3, at+h).Subbed(CubicBezier2(p0, p1, p2, p3, at-h)).Scaled(1 / (2 * h))
+
+		if got := CubicBezierTangent2(p0, p1, p2, p3, at); !got.ApproxEqual(cubic, 1e-6) {
+			t.Errorf("CubicBezierTangent2() at t=%v = %v, want %v", at, got, cubic)
+		}
+	}
+}
+
+func TestVector2ReflectWithRestitution(t *testing.T) {
+	normal := Vector2{X: 0, Y: 1}
+
+	tests := []struct {
+		name        string
+		input       Vector2
+		restitution float64
+		want        Vector2
+	}{
+		{"perfect bounce", Vector2{X: 2, Y: -4}, 1, Vector2{X: 2, Y: 4}},
+		{"half bounce", Vector2{X: 2, Y: -4}, 0.5, Vector2{X: 2, Y: 2}},
+		{"slide", Vector2{X: 2, Y: -4}, 0, Vector2{X: 2, Y: 0}},
+		{"parallel to the surface", Vector2{X: 3, Y: 0}, 0.5, Vector2{X: 3, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.ReflectWithRestitution(normal, tt.restitution)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ReflectWithRestitution(%v, %v) = %v, want %v", tt.input, normal, tt.restitution, got, tt.want)
+			}
+		})
+	}
+
+	reflected := Vector2{X: 1, Y: -3}
+	bounced := reflected
+	reflected.Reflect(normal)
+	bounced.ReflectWithRestitution(normal, 1)
+
+	if !bounced.ApproxEqual(reflected, testEpsilon) {
+		t.Errorf("ReflectWithRestitution() with a restitution of 1 = %v, want Reflect() = %v", bounced, reflected)
+	}
+}
+
+func TestVector2InSlice(t *testing.T) {
+	candidates := []Vector2{{X: 5, Y: 0}, {X: 1, Y: 1}, {X: -1, Y: 1}, {X: -6, Y: -8}}
+
+	tests := []struct {
+		name         string
+		query        Vector2
+		wantClosest  int
+		wantFurthest int
+	}{
+		{"origin", Vector2{}, 1, 3},
+		{"near the first", Vector2{X: 4, Y: 0}, 0, 3},
+		{"near the last", Vector2{X: -5, Y: -7}, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closest, index, distance := ClosestVector2InSlice(tt.query, candidates)
+
+			if index != tt.wantClosest || !closest.Equal(candidates[tt.wantClosest]) || !approxEqual(distance, tt.query.Distance(closest), testEpsilon) {
+				t.Errorf("ClosestVector2InSlice(%v) = (%v, %d, %v), want index %d", tt.query, closest, index, distance, tt.wantClosest)
+			}
+
+			furthest, index, distance := FurthestVector2InSlice(tt.query, candidates)
+
+			if index != tt.wantFurthest || !furthest.Equal(candidates[tt.wantFurthest]) || !approxEqual(distance, tt.query.Distance(furthest), testEpsilon) {
+				t.Errorf("FurthestVector2InSlice(%v) = (%v, %d, %v), want index %d", tt.query, furthest, index, distance, tt.wantFurthest)
+			}
+		})
+	}
+}
+
+func TestVector2InSliceEdgeCases(t *testing.T) {
+	if vec, index, distance := ClosestVector2InSlice(Vector2{X: 1, Y: 1}, nil); !vec.IsZero() || index != -1 || distance != 0 {
+		t.Errorf("ClosestVector2InSlice() of an empty slice = (%v, %d, %v), want ((0.0, 0.0), -1, 0)", vec, index, distance)
+	}
+
+	if vec, index, distance := FurthestVector2InSlice(Vector2{X: 1, Y: 1}, nil); !vec.IsZero() || index != -1 || distance != 0 {
+		t.Errorf("FurthestVector2InSlice() of an empty slice = (%v, %d, %v), want ((0.0, 0.0), -1, 0)", vec, index, distance)
+	}
+
+	ties := []Vector2{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: -1, Y: 0}}
+
+	if _, index, _ := ClosestVector2InSlice(Vector2{}, ties); index != 0 {
+		t.Errorf("ClosestVector2InSlice() with ties returned index %d, want 0", index)
+	}
+
+	if _, index, _ := FurthestVector2InSlice(Vector2{}, ties); index != 0 {
+		t.Errorf("FurthestVector2InSlice() with ties returned index %d, want 0", index)
+	}
+}
+
+func TestVector2Hash(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		name     string
+		a, b     Vector2
+		wantSame bool
+	}{
+		{"equal", Vector2{X: 1.5, Y: -2}, Vector2{X: 1.5, Y: -2}, true},
+		{"negative zero", Vector2{X: negativeZero, Y: negativeZero}, Vector2{}, true},
+		{"different", Vector2{X: 1, Y: 2}, Vector2{X: 1, Y: 2.0000001}, false},
+		{"swapped axes", Vector2{X: 1, Y: 2}, Vector2{X: 2, Y: 1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Hash() == tt.b.Hash(); got != tt.wantSame {
+				t.Errorf("%v.Hash() == %v.Hash() is %v, want %v", tt.a, tt.b, got, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestVector2QuantizedHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     Vector2
+		wantSame bool
+	}{
+		{"same cell", Vector2{X: 1.01, Y: 2.02}, Vector2{X: 0.99, Y: 1.98}, true},
+		{"different cells", Vector2{X: 1.01, Y: 2.02}, Vector2{X: 1.2, Y: 2.02}, false},
+		{"around zero", Vector2{X: -0.01, Y: 0.01}, Vector2{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.QuantizedHash(0.1) == tt.b.QuantizedHash(0.1); got != tt.wantSame {
+				t.Errorf("%v.QuantizedHash(0.1) == %v.QuantizedHash(0.1) is %v, want %v", tt.a, tt.b, got, tt.wantSame)
+			}
+		})
+	}
+}
+
+func TestVector2AngleRelativeTo(t *testing.T) {
+	tests := []struct {
+		name      string
+		v         Vector2
+		reference Vector2
+		want      float64
+	}{
+		{"same direction", Vector2{X: 2, Y: 0}, Vector2{X: 1, Y: 0}, 0},
+		{"quarter turn counterclockwise", Vector2{X: 0, Y: 1}, Vector2{X: 1, Y: 0}, math.Pi / 2},
+		{"quarter turn clockwise", Vector2{X: 1, Y: 0}, Vector2{X: 0, Y: 1}, -math.Pi / 2},
+		{"rotated reference", Vector2{X: -1, Y: 1}, Vector2{X: 0, Y: 1}, math.Pi / 4},
+		{"opposite", Vector2{X: -1, Y: 0}, Vector2{X: 1, Y: 0}, math.Pi},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.AngleRelativeTo(tt.reference); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("%v.AngleRelativeTo(%v) = %v, want %v", tt.v, tt.reference, got, tt.want)
+			}
+
+			if got, want := tt.v.AngleRelativeToDegrees(tt.reference), tt.want*180/math.Pi; !approxEqual(got, want, testEpsilon) {
+				t.Errorf("%v.AngleRelativeToDegrees(%v) = %v, want %v", tt.v, tt.reference, got, want)
+			}
+		})
+	}
+
+	v := Vector2{X: -3, Y: -4}
+
+	if got, want := v.AngleRelativeTo(Vector2{X: 1, Y: 0}), v.AngleRadians(); !approxEqual(got, want, testEpsilon) {
+		t.Errorf("%v.AngleRelativeTo((1, 0)) = %v, want AngleRadians() = %v", v, got, want)
+	}
+}
+
+func TestVector2DirectionToAndFrom(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vector2
+		want Vector2
+	}{
+		{"along Y", Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: 5}, Vector2{X: 0, Y: 1}},
+		{"diagonal", Vector2{X: 1, Y: 1}, Vector2{X: 4, Y: -3}, Vector2{X: 0.6, Y: -0.8}},
+		{"same point", Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}, Vector2{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.DirectionTo(tt.b); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.DirectionTo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := tt.b.DirectionFrom(tt.a); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.DirectionFrom(%v) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+
+			if got, want := tt.a.DirectionTo(tt.b), LookAt2D(tt.a, tt.b); !got.ApproxEqual(want, testEpsilon) {
+				t.Errorf("%v.DirectionTo(%v) = %v, want LookAt2D() = %v", tt.a, tt.b, got, want)
+			}
+		})
+	}
+}
+
+func TestPointOnCircle(t *testing.T) {
+	center := Vector2{X: 1, Y: -1}
+
+	tests := []struct {
+		name  string
+		angle float64
+		want  Vector2
+	}{
+		{"zero", 0, Vector2{X: 3, Y: -1}},
+		{"quarter turn", math.Pi / 2, Vector2{X: 1, Y: 1}},
+		{"half turn", math.Pi, Vector2{X: -1, Y: -1}},
+		{"negative quarter turn", -math.Pi / 2, Vector2{X: 1, Y: -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PointOnCircle(center, 2, tt.angle)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("PointOnCircle(%v, 2, %v) = %v, want %v", center, tt.angle, got, tt.want)
+			}
+
+			if !approxEqual(got.Distance(center), 2, testEpsilon) {
+				t.Errorf("PointOnCircle(%v, 2, %v) is %v away from the center, want 2", center, tt.angle, got.Distance(center))
+			}
+		})
+	}
+}
+
+func TestPointOnEllipse(t *testing.T) {
+	center := Vector2{X: 0, Y: 0}
+
+	tests := []struct {
+		name  string
+		angle float64
+		want  Vector2
+	}{
+		{"zero", 0, Vector2{X: 3, Y: 0}},
+		{"quarter turn", math.Pi / 2, Vector2{X: 0, Y: 1}},
+		{"half turn", math.Pi, Vector2{X: -3, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PointOnEllipse(center, 3, 1, tt.angle); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("PointOnEllipse(%v, 3, 1, %v) = %v, want %v", center, tt.angle, got, tt.want)
+			}
+		})
+	}
+
+	for _, angle := range []float64{0.3, 1.7, -2.5} {
+		point := PointOnEllipse(center, 3, 1, angle)
+
+		if got := (point.X*point.X)/9 + point.Y*point.Y; !approxEqual(got, 1, testEpsilon) {
+			t.Errorf("PointOnEllipse(%v, 3, 1, %v) = %v, which is not on the ellipse", center, angle, point)
+		}
+	}
+}
+
+func TestVector2Swizzles(t *testing.T) {
+	v := Vector2{X: 1, Y: 2}
+
+	if got := v.XY(); !got.Equal(v) {
+		t.Errorf("%v.XY() = %v, want %v", v, got, v)
+	}
+
+	if got, want := v.YX(), (Vector2{X: 2, Y: 1}); !got.Equal(want) {
+		t.Errorf("%v.YX() = %v, want %v", v, got, want)
+	}
+
+	if got, want := v.ToVector3WithZ(3), (Vector3{X: 1, Y: 2, Z: 3}); !got.Equal(want) {
+		t.Errorf("%v.ToVector3WithZ(3) = %v, want %v", v, got, want)
+	}
+}
+
+func TestVector2Mirror(t *testing.T) {
+	v := Vector2{X: 3, Y: 4}
+
+	mirrorX := v
+	mirrorX.MirrorX()
+
+	if want := (Vector2{X: -3, Y: 4}); !mirrorX.Equal(want) {
+		t.Errorf("%v.MirrorX() = %v, want %v", v, mirrorX, want)
+	}
+
+	mirrorY := v
+	mirrorY.MirrorY()
+
+	if want := (Vector2{X: 3, Y: -4}); !mirrorY.Equal(want) {
+		t.Errorf("%v.MirrorY() = %v, want %v", v, mirrorY, want)
+	}
+
+	tests := []struct {
+		name string
+		axis Vector2
+		want Vector2
+	}{
+		{"X axis", Vector2{X: 5, Y: 0}, Vector2{X: 3, Y: -4}},
+		{"Y axis", Vector2{X: 0, Y: -1}, Vector2{X: -3, Y: 4}},
+		{"diagonal", Vector2{X: 1, Y: 1}, Vector2{X: 4, Y: 3}},
+		{"along the vector", Vector2{X: 6, Y: 8}, Vector2{X: 3, Y: 4}},
+		{"zero axis", Vector2{}, Vector2{X: -3, Y: -4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v
+			got.MirrorAcross(tt.axis)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.MirrorAcross(%v) = %v, want %v", v, tt.axis, got, tt.want)
+			}
+
+			if !tt.axis.IsZero() {
+				got.MirrorAcross(tt.axis)
+
+				if !got.ApproxEqual(v, testEpsilon) {
+					t.Errorf("mirroring %v across %v twice = %v", v, tt.axis, got)
+				}
+			}
+		})
+	}
+}
+
+func TestVector2ScaleAround(t *testing.T) {
+	pivot := Vector2{X: 1, Y: 1}
+
+	tests := []struct {
+		name  string
+		input Vector2
+		scale float64
+		want  Vector2
+	}{
+		{"double", Vector2{X: 2, Y: 3}, 2, Vector2{X: 3, Y: 5}},
+		{"half", Vector2{X: 3, Y: -1}, 0.5, Vector2{X: 2, Y: 0}},
+		{"negative", Vector2{X: 2, Y: 1}, -1, Vector2{X: 0, Y: 1}},
+		{"zero", Vector2{X: 5, Y: 5}, 0, pivot},
+		{"at the pivot", pivot, 10, pivot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.ScaleAround(pivot, tt.scale)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ScaleAround(%v, %v) = %v, want %v", tt.input, pivot, tt.scale, got, tt.want)
+			}
+		})
+	}
+
+	nonUniform := Vector2{X: 3, Y: 3}
+	nonUniform.ScaleAroundNonUniform(pivot, Vector2{X: 2, Y: -1})
+
+	if want := (Vector2{X: 5, Y: -1}); !nonUniform.ApproxEqual(want, testEpsilon) {
+		t.Errorf("ScaleAroundNonUniform() = %v, want %v", nonUniform, want)
+	}
+}
+
+func TestVector2Decompose(t *testing.T) {
+	tests := []struct {
+		name              string
+		input             Vector2
+		axis              Vector2
+		wantParallel      Vector2
+		wantPerpendicular Vector2
+	}{
+		{"X axis", Vector2{X: 3, Y: 4}, Vector2{X: 1, Y: 0}, Vector2{X: 3, Y: 0}, Vector2{X: 0, Y: 4}},
+		{"unnormalized axis", Vector2{X: 3, Y: 4}, Vector2{X: 0, Y: -5}, Vector2{X: 0, Y: 4}, Vector2{X: 3, Y: 0}},
+		{"diagonal", Vector2{X: 2, Y: 0}, Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: -1}},
+		{"zero axis", Vector2{X: 3, Y: 4}, Vector2{}, Vector2{}, Vector2{X: 3, Y: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parallel, perpendicular := tt.input.Decompose(tt.axis)
+
+			if !parallel.ApproxEqual(tt.wantParallel, testEpsilon) || !perpendicular.ApproxEqual(tt.wantPerpendicular, testEpsilon) {
+				t.Errorf("%v.Decompose(%v) = (%v, %v), want (%v, %v)", tt.input, tt.axis, parallel, perpendicular, tt.wantParallel, tt.wantPerpendicular)
+			}
+
+			if sum := parallel.Added(perpendicular); !sum.ApproxEqual(tt.input, testEpsilon) {
+				t.Errorf("%v.Decompose(%v) components add up to %v", tt.input, tt.axis, sum)
+			}
+
+			if !approxEqual(perpendicular.Dot(tt.axis), 0, testEpsilon) {
+				t.Errorf("%v.Decompose(%v) perpendicular component %v is not perpendicular", tt.input, tt.axis, perpendicular)
+			}
+		})
+	}
+}
+
+func TestVector2IsInCircle(t *testing.T) {
+	center := Vector2{X: 1, Y: 1}
+
+	tests := []struct {
+		name         string
+		point        Vector2
+		wantInside   bool
+		wantOnCircle bool
+	}{
+		{"center", center, true, false},
+		{"inside", Vector2{X: 2, Y: 2}, true, false},
+		{"on the boundary", Vector2{X: 3, Y: 1}, true, true},
+		{"just outside the boundary", Vector2{X: 3 + 1e-12, Y: 1}, false, true},
+		{"outside", Vector2{X: 3, Y: 3}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.IsInCircle(center, 2); got != tt.wantInside {
+				t.Errorf("%v.IsInCircle(%v, 2) = %v, want %v", tt.point, center, got, tt.wantInside)
+			}
+
+			if got := tt.point.IsOnCircle(center, 2, testEpsilon); got != tt.wantOnCircle {
+				t.Errorf("%v.IsOnCircle(%v, 2) = %v, want %v", tt.point, center, got, tt.wantOnCircle)
+			}
+		})
+	}
+}
+
+func TestVector2IsInAABB(t *testing.T) {
+	minValue := Vector2{X: 0, Y: 0}
+	maxValue := Vector2{X: 2, Y: 2}
+
+	tests := []struct {
+		name          string
+		point         Vector2
+		wantInclusive bool
+		wantExclusive bool
+	}{
+		{"inside", Vector2{X: 1, Y: 1}, true, true},
+		{"left", Vector2{X: -1, Y: 1}, false, false},
+		{"right", Vector2{X: 3, Y: 1}, false, false},
+		{"below", Vector2{X: 1, Y: -1}, false, false},
+		{"above", Vector2{X: 1, Y: 3}, false, false},
+		{"below left", Vector2{X: -1, Y: -1}, false, false},
+		{"below right", Vector2{X: 3, Y: -1}, false, false},
+		{"above left", Vector2{X: -1, Y: 3}, false, false},
+		{"above right", Vector2{X: 3, Y: 3}, false, false},
+		{"on the left edge", Vector2{X: 0, Y: 1}, true, false},
+		{"on the top edge", Vector2{X: 1, Y: 2}, true, false},
+		{"on the min corner", minValue, true, false},
+		{"on the max corner", maxValue, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.IsInAABB(minValue, maxValue); got != tt.wantInclusive {
+				t.Errorf("%v.IsInAABB(%v, %v) = %v, want %v", tt.point, minValue, maxValue, got, tt.wantInclusive)
+			}
+
+			if got := tt.point.IsInAABBExclusive(minValue, maxValue); got != tt.wantExclusive {
+				t.Errorf("%v.IsInAABBExclusive(%v, %v) = %v, want %v", tt.point, minValue, maxValue, got, tt.wantExclusive)
+			}
+
+			if got := NewAABB2D(minValue, maxValue).Contains(tt.point); got != tt.wantInclusive {
+				t.Errorf("AABB2D.Contains(%v) = %v, want IsInAABB() = %v", tt.point, got, tt.wantInclusive)
+			}
+		})
+	}
+
+	point := Vector2{X: 1, Y: 1}
+
+	if point.IsInAABB(maxValue, minValue) || point.IsInAABBExclusive(maxValue, minValue) {
+		t.Errorf("%v is inside the inverted box from %v to %v", point, maxValue, minValue)
+	}
+}
+
+func TestVector2NearestPointOnLine(t *testing.T) {
+	tests := []struct {
+		name         string
+		point        Vector2
+		linePoint    Vector2
+		lineDir      Vector2
+		want         Vector2
+		wantDistance float64
+	}{
+		{"above the X axis", Vector2{X: 3, Y: 4}, Vector2{}, Vector2{X: 1, Y: 0}, Vector2{X: 3, Y: 0}, 4},
+		{"beyond the line point", Vector2{X: -5, Y: 2}, Vector2{X: 1, Y: 0}, Vector2{X: 2, Y: 0}, Vector2{X: -5, Y: 0}, 2},
+		{"diagonal line", Vector2{X: 2, Y: 0}, Vector2{}, Vector2{X: 1, Y: 1}, Vector2{X: 1, Y: 1}, math.Sqrt2},
+		{"on the line", Vector2{X: 3, Y: 3}, Vector2{}, Vector2{X: -1, Y: -1}, Vector2{X: 3, Y: 3}, 0},
+		{"zero direction", Vector2{X: 4, Y: 5}, Vector2{X: 1, Y: 1}, Vector2{}, Vector2{X: 1, Y: 1}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.NearestPointOnLine(tt.linePoint, tt.lineDir); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.NearestPointOnLine(%v, %v) = %v, want %v", tt.point, tt.linePoint, tt.lineDir, got, tt.want)
+			}
+
+			if got := tt.point.DistanceToLine(tt.linePoint, tt.lineDir); !approxEqual(got, tt.wantDistance, testEpsilon) {
+				t.Errorf("%v.DistanceToLine(%v, %v) = %v, want %v", tt.point, tt.linePoint, tt.lineDir, got, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestVector2Complex(t *testing.T) {
+	for _, v := range []Vector2{{X: 0, Y: 0}, {X: 1.5, Y: -2}, {X: -3, Y: 4}} {
+		c := v.ToComplex()
+
+		if real(c) != v.X || imag(c) != v.Y {
+			t.Errorf("%v.ToComplex() = %v", v, c)
+		}
+
+		if got := Vector2FromComplex(c); !got.Equal(v) {
+			t.Errorf("Vector2FromComplex(%v) = %v, want %v", c, got, v)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		v       Vector2
+		radians float64
+	}{
+		{"quarter turn", Vector2{X: 1, Y: 0}, math.Pi / 2},
+		{"negative angle", Vector2{X: 3, Y: 4}, -0.7},
+		{"half turn", Vector2{X: -2, Y: 1}, math.Pi},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rotated := Vector2FromComplex(tt.v.ToComplex() * cmplx.Rect(1, tt.radians))
+
+			want := tt.v
+			want.Rotate(tt.radians)
+
+			if !rotated.ApproxEqual(want, testEpsilon) {
+				t.Errorf("multiplying %v by a unit complex number of angle %v = %v, want %v", tt.v, tt.radians, rotated, want)
+			}
+		})
+	}
+}
+
+func TestVector2PackFloat32(t *testing.T) {
+	v := Vector2{X: 1.5, Y: -2}
+	packed := v.PackFloat32()
+
+	if want := [8]byte{0x00, 0x00, 0xc0, 0x3f, 0x00, 0x00, 0x00, 0xc0}; packed != want {
+		t.Errorf("%v.PackFloat32() = %x, want %x", v, packed, want)
+	}
+
+	for _, v := range []Vector2{{X: 0, Y: 0}, {X: 1.5, Y: -2}, {X: 0.1, Y: 1e30}, {X: math.Inf(1), Y: -1e-40}} {
+		got := UnpackVector2Float32(v.PackFloat32())
+		want := Vector2{X: float64(float32(v.X)), Y: float64(float32(v.Y))}
+
+		if !got.Equal(want) {
+			t.Errorf("PackFloat32 round trip of %v = %v, want %v", v, got, want)
+		}
+
+		if got32 := v.ToFloat32Array2(); float64(got32[0]) != want.X || float64(got32[1]) != want.Y {
+			t.Errorf("%v.ToFloat32Array2() = %v, want %v", v, got32, want)
+		}
+
+		if got := v.ToGLArray(); got != v.ToFloat32Array2() {
+			t.Errorf("%v.ToGLArray() = %v, want %v", v, got, v.ToFloat32Array2())
+		}
+	}
+}
+
+func TestVector2MortonCode(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector2
+		want uint64
+	}{
+		{"origin", Vector2{X: 0, Y: 0}, 0},
+		{"X", Vector2{X: 1, Y: 0}, 1},
+		{"Y", Vector2{X: 0, Y: 1}, 2},
+		{"both", Vector2{X: 1, Y: 1}, 3},
+		{"next cell", Vector2{X: 2, Y: 0}, 4},
+		{"fractional", Vector2{X: 3.9, Y: 2.1}, 13},
+		{"negative clamps to zero", Vector2{X: -5, Y: -1}, 0},
+		{"NaN maps to zero", Vector2{X: math.NaN(), Y: 1}, 2},
+		{"large clamps to 16 bits", Vector2{X: 1e9, Y: 1e9}, 0xffffffff},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.MortonCode(1, 0); got != tt.want {
+				t.Errorf("%v.MortonCode(1, 0) = %d, want %d", tt.v, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := (Vector2{X: -1, Y: -1}).MortonCode(2, 1), (Vector2{}).MortonCode(1, 0); got != want {
+		t.Errorf("MortonCode(2, 1) of (-1, -1) = %d, want %d", got, want)
+	}
+}
+
+func TestVector2MortonCodeLocality(t *testing.T) {
+	// Sorting a grid by Morton code visits each 2x2 block completely before moving on to the next one.
+	grid := make([]Vector2, 0, 64)
+
+	for y := range 8 {
+		for x := range 8 {
+			grid = append(grid, Vector2{X: float64(x), Y: float64(y)})
+		}
+	}
+
+	slices.SortFunc(grid, func(a, b Vector2) int {
+		return cmp.Compare(a.MortonCode(1, 0), b.MortonCode(1, 0))
+	})
+
+	for i, v := range grid {
+		if got := v.MortonCode(1, 0); got != uint64(i) {
+			t.Fatalf("MortonCode() of %v = %d, want %d", v, got, i)
+		}
+	}
+
+	for block := 0; block < len(grid); block += 4 {
+		first := grid[block]
+
+		for _, v := range grid[block : block+4] {
+			if math.Floor(v.X/2) != math.Floor(first.X/2) || math.Floor(v.Y/2) != math.Floor(first.Y/2) {
+				t.Errorf("Morton order puts %v in the same run as %v, which are in different 2x2 blocks", v, first)
+			}
+		}
+	}
+}
+
+func TestVector2Midpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vector2
+		want Vector2
+	}{
+		{"same point", Vector2{X: 1, Y: 2}, Vector2{X: 1, Y: 2}, Vector2{X: 1, Y: 2}},
+		{"origin", Vector2{X: 0, Y: 0}, Vector2{X: 4, Y: -2}, Vector2{X: 2, Y: -1}},
+		{"mixed", Vector2{X: -3, Y: 5}, Vector2{X: 1, Y: 1}, Vector2{X: -1, Y: 3}},
+		{"opposite extremes", Vector2{X: math.MaxFloat64, Y: 0}, Vector2{X: -math.MaxFloat64, Y: 0}, Vector2{X: 0, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.a
+
+			if got := a.Midpoint(tt.b); !got.Equal(tt.want) {
+				t.Errorf("%v.Midpoint(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if a != tt.a {
+				t.Errorf("Midpoint() mutated the receiver to %v, want %v", a, tt.a)
+			}
+		})
+	}
+}
+
+func TestVector2WeightedMidpoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   Vector2
+		weight float64
+		want   Vector2
+	}{
+		{"weight 0", Vector2{X: 1, Y: 2}, Vector2{X: 5, Y: -2}, 0, Vector2{X: 1, Y: 2}},
+		{"weight 1", Vector2{X: 1, Y: 2}, Vector2{X: 5, Y: -2}, 1, Vector2{X: 5, Y: -2}},
+		{"weight 0.25", Vector2{X: 1, Y: 2}, Vector2{X: 5, Y: -2}, 0.25, Vector2{X: 2, Y: 1}},
+		{"weight 0.5", Vector2{X: 1, Y: 2}, Vector2{X: 5, Y: -2}, 0.5, Vector2{X: 3, Y: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.a
+
+			if got := a.WeightedMidpoint(tt.b, tt.weight); !got.Equal(tt.want) {
+				t.Errorf("%v.WeightedMidpoint(%v, %v) = %v, want %v", tt.a, tt.b, tt.weight, got, tt.want)
+			}
+
+			if a != tt.a {
+				t.Errorf("WeightedMidpoint() mutated the receiver to %v, want %v", a, tt.a)
+			}
+		})
+	}
+}
+
+func TestWeightedAverageVector2(t *testing.T) {
+	tests := []struct {
+		name    string
+		vecs    []Vector2
+		weights []float64
+		want    Vector2
+		wantErr error
+	}{
+		{"equal weights", []Vector2{{X: 0, Y: 0}, {X: 4, Y: 2}}, []float64{1, 1}, Vector2{X: 2, Y: 1}, nil},
+		{"uneven weights", []Vector2{{X: 0, Y: 0}, {X: 4, Y: 2}}, []float64{3, 1}, Vector2{X: 1, Y: 0.5}, nil},
+		{"single vector", []Vector2{{X: -1, Y: 7}}, []float64{0.25}, Vector2{X: -1, Y: 7}, nil},
+		{"negative weight", []Vector2{{X: 1, Y: 0}, {X: 0, Y: 1}}, []float64{2, -1}, Vector2{X: 2, Y: -1}, nil},
+		{"length mismatch", []Vector2{{X: 1, Y: 0}}, []float64{1, 1}, Vector2{}, ErrLengthMismatch},
+		{"empty", []Vector2{}, []float64{}, Vector2{}, ErrZeroTotalWeight},
+		{"all zero weights", []Vector2{{X: 1, Y: 0}, {X: 0, Y: 1}}, []float64{0, 0}, Vector2{}, ErrZeroTotalWeight},
+		{"cancelling weights", []Vector2{{X: 1, Y: 0}, {X: 0, Y: 1}}, []float64{1, -1}, Vector2{}, ErrZeroTotalWeight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WeightedAverageVector2(tt.vecs, tt.weights)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WeightedAverageVector2(%v, %v) error = %v, want %v", tt.vecs, tt.weights, err, tt.wantErr)
+			}
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("WeightedAverageVector2(%v, %v) = %v, want %v", tt.vecs, tt.weights, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBiLerpVector2(t *testing.T) {
+	a := Vector2{X: 0, Y: 0}
+	b := Vector2{X: 4, Y: 0}
+	c := Vector2{X: 0, Y: 2}
+	d := Vector2{X: 6, Y: 4}
+
+	tests := []struct {
+		name string
+		s, t float64
+		want Vector2
+	}{
+		{"corner a", 0, 0, a},
+		{"corner b", 1, 0, b},
+		{"corner c", 0, 1, c},
+		{"corner d", 1, 1, d},
+		{"center", 0.5, 0.5, Vector2{X: 2.5, Y: 1.5}},
+		{"bottom edge", 0.25, 0, Vector2{X: 1, Y: 0}},
+		{"top edge", 0.5, 1, Vector2{X: 3, Y: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BiLerpVector2(a, b, c, d, tt.s, tt.t); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("BiLerpVector2(%v, %v) = %v, want %v", tt.s, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2Clamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         Vector2
+		minValue      Vector2
+		maxValue      Vector2
+		want          Vector2
+		wantPanicAxis string
+	}{
+		{"inside", Vector2{X: 1, Y: 2}, Vector2{X: 0, Y: 0}, Vector2{X: 5, Y: 5}, Vector2{X: 1, Y: 2}, ""},
+		{"below", Vector2{X: -3, Y: -1}, Vector2{X: 0, Y: -0.5}, Vector2{X: 5, Y: 5}, Vector2{X: 0, Y: -0.5}, ""},
+		{"above", Vector2{X: 9, Y: 7}, Vector2{X: 0, Y: 0}, Vector2{X: 5, Y: 6}, Vector2{X: 5, Y: 6}, ""},
+		{"mixed", Vector2{X: -9, Y: 9}, Vector2{X: -1, Y: -1}, Vector2{X: 1, Y: 1}, Vector2{X: -1, Y: 1}, ""},
+		{"equal bounds", Vector2{X: 3, Y: -3}, Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}, Vector2{X: 2, Y: 2}, ""},
+		{"inverted X", Vector2{X: 1, Y: 1}, Vector2{X: 5, Y: 0}, Vector2{X: 0, Y: 5}, Vector2{}, "X"},
+		{"inverted Y", Vector2{X: 1, Y: 1}, Vector2{X: 0, Y: 5}, Vector2{X: 5, Y: 0}, Vector2{}, "Y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+
+				if tt.wantPanicAxis == "" {
+					if r != nil {
+						t.Errorf("Clamp(%v, %v) panicked: %v", tt.minValue, tt.maxValue, r)
+					}
+
+					return
+				}
+
+				if msg, _ := r.(string); !strings.Contains(msg, tt.wantPanicAxis+" axis") {
+					t.Errorf("Clamp(%v, %v) panic = %v, want it to name the %s axis", tt.minValue, tt.maxValue, r, tt.wantPanicAxis)
+				}
+			}()
+
+			got := tt.input
+			got.Clamp(tt.minValue, tt.maxValue)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("%v.Clamp(%v, %v) = %v, want %v", tt.input, tt.minValue, tt.maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2ClampScalar(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Vector2
+		minValue  float64
+		maxValue  float64
+		want      Vector2
+		wantPanic bool
+	}{
+		{"inside", Vector2{X: 0.5, Y: 0.25}, 0, 1, Vector2{X: 0.5, Y: 0.25}, false},
+		{"outside", Vector2{X: -2, Y: 3}, 0, 1, Vector2{X: 0, Y: 1}, false},
+		{"equal bounds", Vector2{X: -2, Y: 3}, 1, 1, Vector2{X: 1, Y: 1}, false},
+		{"inverted", Vector2{X: 0.5, Y: 0.5}, 1, 0, Vector2{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("ClampScalar(%v, %v) panicked = %v, want %v", tt.minValue, tt.maxValue, r != nil, tt.wantPanic)
+				}
+			}()
+
+			got := tt.input
+			got.ClampScalar(tt.minValue, tt.maxValue)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("%v.ClampScalar(%v, %v) = %v, want %v", tt.input, tt.minValue, tt.maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2GetSet(t *testing.T) {
+	v := Vector2{X: 1.5, Y: -2}
+	want := []float64{1.5, -2}
+
+	if got := v.Len(); got != len(want) {
+		t.Fatalf("%v.Len() = %d, want %d", v, got, len(want))
+	}
+
+	for i := range v.Len() {
+		if got := v.Get(i); got != want[i] {
+			t.Errorf("%v.Get(%d) = %v, want %v", v, i, got, want[i])
+		}
+
+		var set Vector2
+		set.Set(i, want[i])
+
+		if got := set.Get(i); got != want[i] {
+			t.Errorf("Get(%d) after Set(%d, %v) = %v, want %v", i, i, want[i], got, want[i])
+		}
+
+		for j := range set.Len() {
+			if j != i && set.Get(j) != 0 {
+				t.Errorf("Set(%d, %v) changed index %d to %v", i, want[i], j, set.Get(j))
+			}
+		}
+	}
+
+	for _, i := range []int{-1, v.Len()} {
+		t.Run(fmt.Sprintf("index %d", i), func(t *testing.T) {
+			for name, access := range map[string]func(){
+				"Get": func() { v.Get(i) },
+				"Set": func() { v.Set(i, 1) },
+			} {
+				func() {
+					defer func() {
+						if msg, _ := recover().(string); !strings.Contains(msg, "out of range") {
+							t.Errorf("%s(%d) panic = %q, want an out of range panic", name, i, msg)
+						}
+					}()
+
+					access()
+				}()
+			}
+		})
+	}
+}
+
+func TestVector2Components(t *testing.T) {
+	v := Vector2{X: 1.5, Y: -2}
+	components := v.Components()
+
+	if want := []float64{1.5, -2}; !slices.Equal(components, want) {
+		t.Fatalf("%v.Components() = %v, want %v", v, components, want)
+	}
+
+	components[0] = 100
+
+	if v.X != 1.5 {
+		t.Errorf("modifying the result of Components() changed the vector to %v", v)
+	}
+
+	if again := v.Components(); again[0] != 1.5 {
+		t.Errorf("Components() returned a shared slice, got %v after modifying an earlier result", again)
+	}
+}
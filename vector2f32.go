@@ -0,0 +1,33 @@
+package vectors
+
+// IVector2f32 is the interface for a 2D vector with float32 components.
+type IVector2f32 = IVec2[float32]
+
+// Vector2f32 is a 2D vector with float32 X and Y coordinates,
+// for applications that need float32 precision, such as GPU-facing code.
+type Vector2f32 = Vec2[float32]
+
+// NewVector2f32 creates a new 2D float32 vector from its coordinates.
+func NewVector2f32(x, y float32) Vector2f32 {
+	return Vector2f32{
+		X: x,
+		Y: y,
+	}
+}
+
+// Vector2ToFloat32 converts a Vector2 to a Vector2f32, rounding each component to the nearest float32.
+// It is shorthand for Vec2FromVector2[float32].
+func Vector2ToFloat32(v Vector2) Vector2f32 {
+	return Vec2FromVector2[float32](v)
+}
+
+// Vector2FromFloat32 converts a Vector2f32 to a Vector2.
+// It is shorthand for Vector2FromVec2[float32].
+func Vector2FromFloat32(v Vector2f32) Vector2 {
+	return Vector2FromVec2(v)
+}
+
+// ToGLArray returns the coordinates of the vector as a float32 array, for use with OpenGL bindings.
+func (v Vec2[T]) ToGLArray() [2]float32 {
+	return v.ToFloat32Array2()
+}
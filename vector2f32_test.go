@@ -0,0 +1,52 @@
+package vectors
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVector2ToFloat32(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2f32
+	}{
+		{"exact", Vector2{X: 1.5, Y: -2.25}, NewVector2f32(1.5, -2.25)},
+		{"rounds to nearest", Vector2{X: 0.1, Y: 1 + 1e-9}, NewVector2f32(0.1, 1)},
+		{"overflows to infinity", Vector2{X: 1e39, Y: -1e39}, NewVector2f32(float32(math.Inf(1)), float32(math.Inf(-1)))},
+		{"underflows to zero", Vector2{X: 1e-50, Y: -1e-50}, NewVector2f32(0, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Vector2ToFloat32(tt.input)
+
+			if got != tt.want {
+				t.Errorf("Vector2ToFloat32(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			if generic := Vec2FromVector2[float32](tt.input); got != generic {
+				t.Errorf("Vector2ToFloat32(%v) = %v, but Vec2FromVector2[float32] = %v", tt.input, got, generic)
+			}
+
+			if gl := got.ToGLArray(); gl != [2]float32{got.X, got.Y} {
+				t.Errorf("%v.ToGLArray() = %v, want %v", got, gl, [2]float32{got.X, got.Y})
+			}
+		})
+	}
+}
+
+func TestVector2FromFloat32(t *testing.T) {
+	// Widening is exact, so narrowing a widened vector gives back the original.
+	for _, v := range []Vector2f32{NewVector2f32(0.1, -3), NewVector2f32(math.MaxFloat32, math.SmallestNonzeroFloat32)} {
+		got := Vector2FromFloat32(v)
+
+		if want := (Vector2{X: float64(v.X), Y: float64(v.Y)}); got != want {
+			t.Errorf("Vector2FromFloat32(%v) = %v, want %v", v, got, want)
+		}
+
+		if back := Vector2ToFloat32(got); back != v {
+			t.Errorf("Vector2ToFloat32(Vector2FromFloat32(%v)) = %v, want %v", v, back, v)
+		}
+	}
+}
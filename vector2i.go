@@ -0,0 +1,132 @@
+package vectors
+
+import (
+	"math"
+)
+
+// IVector2i is the interface for a 2D integer vector.
+type IVector2i interface {
+	Add(vec Vector2i)
+	Sub(vec Vector2i)
+	Mul(vec Vector2i)
+	Div(vec Vector2i)
+	Scale(scale int)
+	Bounce()
+	IsZero() bool
+	Magnitude() float64
+	MagnitudeSquared() int
+	Distance(vec Vector2i) float64
+	DistanceSquared(vec Vector2i) int
+	Dot(vec Vector2i) int
+	Clear()
+	ToVector2() Vector2
+}
+
+// Vector2i represents a 2D vector with integer X and Y coordinates.
+// It provides methods for vector operations on grids.
+type Vector2i struct {
+	X int
+	Y int
+}
+
+var _ IVector2i = (*Vector2i)(nil)
+
+// NewVector2i creates a new 2D integer vector from its coordinates.
+func NewVector2i(x, y int) Vector2i {
+	return Vector2i{
+		X: x,
+		Y: y,
+	}
+}
+
+// Add adds the values of another vector to this one.
+func (v *Vector2i) Add(vec Vector2i) {
+	v.X += vec.X
+	v.Y += vec.Y
+}
+
+// Sub subtracts the values of another vector from this one.
+func (v *Vector2i) Sub(vec Vector2i) {
+	v.X -= vec.X
+	v.Y -= vec.Y
+}
+
+// Mul multiplies this vector by another vector.
+func (v *Vector2i) Mul(vec Vector2i) {
+	v.X *= vec.X
+	v.Y *= vec.Y
+}
+
+// Div divides this vector by another vector, truncating toward zero.
+// It panics if any component of the other vector is zero.
+func (v *Vector2i) Div(vec Vector2i) {
+	if vec.X == 0 {
+		panic("vectors: Vector2i division by zero on the X axis")
+	}
+
+	if vec.Y == 0 {
+		panic("vectors: Vector2i division by zero on the Y axis")
+	}
+
+	v.X /= vec.X
+	v.Y /= vec.Y
+}
+
+// Scale multiplies this vector by a scale.
+func (v *Vector2i) Scale(scale int) {
+	v.X *= scale
+	v.Y *= scale
+}
+
+// Bounce inverts the direction of the vector.
+func (v *Vector2i) Bounce() {
+	v.X = -v.X
+	v.Y = -v.Y
+}
+
+// IsZero checks if all axes are zero.
+func (v Vector2i) IsZero() bool {
+	return v.X == 0 && v.Y == 0
+}
+
+// Magnitude returns the length of the vector.
+func (v Vector2i) Magnitude() float64 {
+	return math.Sqrt(float64(v.MagnitudeSquared()))
+}
+
+// MagnitudeSquared returns the squared magnitude of the vector.
+func (v Vector2i) MagnitudeSquared() int {
+	return (v.X * v.X) + (v.Y * v.Y)
+}
+
+// Distance returns the distance between this vector and another vector.
+func (v Vector2i) Distance(vec Vector2i) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(vec)))
+}
+
+// DistanceSquared returns the squared distance between this vector and another vector.
+func (v Vector2i) DistanceSquared(vec Vector2i) int {
+	dx := v.X - vec.X
+	dy := v.Y - vec.Y
+	return dx*dx + dy*dy
+}
+
+// Dot returns the dot product.
+// Positive = same direction, negative = opposite, zero = perpendicular.
+func (v Vector2i) Dot(vec Vector2i) int {
+	return v.X*vec.X + v.Y*vec.Y
+}
+
+// Clear sets the vector to zero.
+func (v *Vector2i) Clear() {
+	v.X = 0
+	v.Y = 0
+}
+
+// ToVector2 converts the integer vector to a floating-point vector.
+func (v Vector2i) ToVector2() Vector2 {
+	return Vector2{
+		X: float64(v.X),
+		Y: float64(v.Y),
+	}
+}
@@ -0,0 +1,137 @@
+package vectors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVector2iArithmetic(t *testing.T) {
+	a := NewVector2i(3, -4)
+	b := NewVector2i(-2, 5)
+
+	tests := []struct {
+		name string
+		op   func(v *Vector2i)
+		want Vector2i
+	}{
+		{"Add", func(v *Vector2i) { v.Add(b) }, Vector2i{X: 1, Y: 1}},
+		{"Sub", func(v *Vector2i) { v.Sub(b) }, Vector2i{X: 5, Y: -9}},
+		{"Mul", func(v *Vector2i) { v.Mul(b) }, Vector2i{X: -6, Y: -20}},
+		{"Scale", func(v *Vector2i) { v.Scale(-3) }, Vector2i{X: -9, Y: 12}},
+		{"Bounce", func(v *Vector2i) { v.Bounce() }, Vector2i{X: -3, Y: 4}},
+		{"Clear", func(v *Vector2i) { v.Clear() }, Vector2i{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := a
+			tt.op(&got)
+
+			if got != tt.want {
+				t.Errorf("%v.%s() = %v, want %v", a, tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2iMeasurements(t *testing.T) {
+	a := Vector2i{X: 3, Y: -4}
+	b := Vector2i{X: -1, Y: -1}
+
+	if got := a.MagnitudeSquared(); got != 25 {
+		t.Errorf("%v.MagnitudeSquared() = %d, want 25", a, got)
+	}
+
+	if got := a.Magnitude(); got != 5 {
+		t.Errorf("%v.Magnitude() = %v, want 5", a, got)
+	}
+
+	if got := a.DistanceSquared(b); got != 25 {
+		t.Errorf("%v.DistanceSquared(%v) = %d, want 25", a, b, got)
+	}
+
+	if got := a.Distance(b); got != 5 {
+		t.Errorf("%v.Distance(%v) = %v, want 5", a, b, got)
+	}
+
+	if got := a.Dot(b); got != 1 {
+		t.Errorf("%v.Dot(%v) = %d, want 1", a, b, got)
+	}
+
+	if a.IsZero() || !(Vector2i{}).IsZero() {
+		t.Errorf("IsZero() is wrong for %v or the zero vector", a)
+	}
+}
+
+func TestVector2iDiv(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         Vector2i
+		divisor       Vector2i
+		want          Vector2i
+		wantPanicAxis string
+	}{
+		{"exact", Vector2i{X: 6, Y: -8}, Vector2i{X: 3, Y: 2}, Vector2i{X: 2, Y: -4}, ""},
+		{"truncates positive", Vector2i{X: 7, Y: 5}, Vector2i{X: 2, Y: 3}, Vector2i{X: 3, Y: 1}, ""},
+		{"truncates toward zero", Vector2i{X: -7, Y: 7}, Vector2i{X: 2, Y: -3}, Vector2i{X: -3, Y: -2}, ""},
+		{"zero X", Vector2i{X: 1, Y: 1}, Vector2i{X: 0, Y: 1}, Vector2i{}, "X"},
+		{"zero Y", Vector2i{X: 1, Y: 1}, Vector2i{X: 1, Y: 0}, Vector2i{}, "Y"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+
+				if tt.wantPanicAxis == "" {
+					if r != nil {
+						t.Errorf("Div(%v) panicked: %v", tt.divisor, r)
+					}
+
+					return
+				}
+
+				if msg, _ := r.(string); !strings.Contains(msg, "division by zero on the "+tt.wantPanicAxis+" axis") {
+					t.Errorf("Div(%v) panic = %v, want a division by zero on the %s axis", tt.divisor, r, tt.wantPanicAxis)
+				}
+			}()
+
+			got := tt.input
+			got.Div(tt.divisor)
+
+			if got != tt.want {
+				t.Errorf("%v.Div(%v) = %v, want %v", tt.input, tt.divisor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2iConversion(t *testing.T) {
+	for _, v := range []Vector2i{{}, {X: 3, Y: -4}, {X: -1 << 40, Y: 1 << 40}} {
+		if got := v.ToVector2().ToVector2i(); got != v {
+			t.Errorf("%v.ToVector2().ToVector2i() = %v, want %v", v, got, v)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		input Vector2
+		want  Vector2i
+	}{
+		{"integers", Vector2{X: 2, Y: -3}, Vector2i{X: 2, Y: -3}},
+		{"truncates toward zero", Vector2{X: 2.9, Y: -2.9}, Vector2i{X: 2, Y: -2}},
+		{"small fractions", Vector2{X: 0.5, Y: -0.5}, Vector2i{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.ToVector2i(); got != tt.want {
+				t.Errorf("%v.ToVector2i() = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := (Vector2i{X: 3, Y: -4}).ToVector2(), (Vector2{X: 3, Y: -4}); got != want {
+		t.Errorf("Vector2i{3, -4}.ToVector2() = %v, want %v", got, want)
+	}
+}
@@ -1,40 +1,180 @@
 package vectors
 
 import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
 	"math"
+	"math/rand/v2"
+	"strconv"
+	"strings"
 )
 
-// IVector3 is the interface for a 3D vector.
-type IVector3 interface {
-	Add(vec Vector3)
-	Sub(vec Vector3)
-	Mul(vec Vector3)
-	Div(vec Vector3)
-	Scale(scale float64)
+// IVec3 is the interface for a 3D vector with components of type T.
+type IVec3[T Float] interface {
+	Add(vec Vec3[T])
+	Added(vec Vec3[T]) Vec3[T]
+	Sub(vec Vec3[T])
+	Subbed(vec Vec3[T]) Vec3[T]
+	Mul(vec Vec3[T])
+	Muled(vec Vec3[T]) Vec3[T]
+	Div(vec Vec3[T])
+	Dived(vec Vec3[T]) Vec3[T]
+	Scale(scale T)
+	Scaled(scale T) Vec3[T]
 	Bounce()
 	Normalize()
-	AngleRadians() float64
-	AngleDegrees() float64
+	Normalized() Vec3[T]
+	AngleRadians() T
+	AngleDegrees() T
+	AngleBetween(vec Vec3[T]) T
+	AngleBetweenDegrees(vec Vec3[T]) T
 	IsZero() bool
-	Magnitude() float64
-	MagnitudeSquared() float64
-	Distance(vec Vector3) float64
-	DistanceSquared(vec Vector3) float64
-	Dot(vec Vector3) float64
-	Lerp(vec Vector3, t float64)
-	ClampMagnitude(maxValue float64)
+	IsNaN() bool
+	IsInf() bool
+	IsFinite() bool
+	IsNormalized() bool
+	Magnitude() T
+	MagnitudeSquared() T
+	Distance(vec Vec3[T]) T
+	DistanceSquared(vec Vec3[T]) T
+	Dot(vec Vec3[T]) T
+	Cross(vec Vec3[T]) Vec3[T]
+	Lerp(vec Vec3[T], t T)
+	LerpUnclamped(target Vec3[T], t T)
+	LerpClamped(target Vec3[T], t T)
+	ClampMagnitude(maxValue T)
 	Clear()
-	ToVector2() Vector2
+	Reflect(normal Vec3[T])
+	Project(onto Vec3[T])
+	Reject(from Vec3[T])
+	String() string
+	GoString() string
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+	Equal(vec Vec3[T]) bool
+	ApproxEqual(vec Vec3[T], epsilon T) bool
+	Clamp(minValue, maxValue Vec3[T])
+	ClampScalar(minValue, maxValue T)
+	Abs()
+	Absed() Vec3[T]
+	Floor()
+	Ceil()
+	Round()
+	Trunc()
+	ComponentMin(vec Vec3[T])
+	ComponentMax(vec Vec3[T])
+	Slerp(target Vec3[T], t T)
+	RotateAroundAxis(axis Vec3[T], radians T)
+	MoveTowards(target Vec3[T], maxDelta T)
+	SmoothStep(target Vec3[T], t T)
+	SmootherStep(target Vec3[T], t T)
+	SafeDiv(vec Vec3[T]) error
+	ClampMagnitudeRange(minValue, maxValue T)
+	SetMagnitude(magnitude T)
+	IsParallel(vec Vec3[T], epsilon T) bool
+	IsPerpendicular(vec Vec3[T], epsilon T) bool
+	Clone() Vec3[T]
+	SnapToGrid(gridSize T)
+	SnapToGridXYZ(gridX, gridY, gridZ T)
+	ComponentSum() T
+	ComponentProduct() T
+	MaxComponent() T
+	MinComponent() T
+	MaxComponentIndex() int
+	MinComponentIndex() int
+	SafeNormalize(fallback Vec3[T])
+	MarshalText() ([]byte, error)
+	UnmarshalText(data []byte) error
+	ManhattanDistance(vec Vec3[T]) T
+	ChebyshevDistance(vec Vec3[T]) T
+	MinkowskiDistance(vec Vec3[T], p T) T
+	CosineSimilarity(vec Vec3[T]) T
+	Wrap(minValue, maxValue Vec3[T])
+	WrapScalar(minValue, maxValue T)
+	ToroidalDistance(vec, size Vec3[T]) T
+	ClosestPointOnSegment(a, b Vec3[T]) Vec3[T]
+	DistanceToSegment(a, b Vec3[T]) T
+	Get(i int) T
+	Set(i int, val T)
+	Len() int
+	Components() []T
+	Map(f func(T) T) Vec3[T]
+	Saturate()
+	Saturated() Vec3[T]
+	Fract()
+	Fracted() Vec3[T]
+	Step(edge Vec3[T]) Vec3[T]
+	SmoothStepComponent(edge0, edge1 Vec3[T]) Vec3[T]
+	Quantize(precision int)
+	QuantizeToStep(step T)
+	ReflectWithRestitution(normal Vec3[T], restitution T)
+	Hash() uint64
+	QuantizedHash(step T) uint64
+	DirectionTo(target Vec3[T]) Vec3[T]
+	DirectionFrom(source Vec3[T]) Vec3[T]
+	XY() Vec2[T]
+	XZ() Vec2[T]
+	YZ() Vec2[T]
+	YX() Vec2[T]
+	ZX() Vec2[T]
+	ZY() Vec2[T]
+	ProjectOntoPlane(planeNormal Vec3[T])
+	ProjectedOntoPlane(planeNormal Vec3[T]) Vec3[T]
+	ScaleAround(pivot Vec3[T], scale T)
+	ScaleAroundNonUniform(pivot, scale Vec3[T])
+	Reciprocal()
+	RecipMul(vec Vec3[T]) Vec3[T]
+	Decompose(axis Vec3[T]) (parallel, perpendicular Vec3[T])
+	ToAxisAngle() (axis Vec3[T], angleRadians T)
+	Midpoint(vec Vec3[T]) Vec3[T]
+	WeightedMidpoint(vec Vec3[T], weight T) Vec3[T]
+	IsInSphere(center Vec3[T], radius T) bool
+	IsOnSphere(center Vec3[T], radius, epsilon T) bool
+	IsInAABB(minValue, maxValue Vec3[T]) bool
+	IsInAABBExclusive(minValue, maxValue Vec3[T]) bool
+	OrthogonalVector() Vec3[T]
+	NearestPointOnLine(linePoint, lineDir Vec3[T]) Vec3[T]
+	DistanceToLine(linePoint, lineDir Vec3[T]) T
+	PackFloat32() [12]byte
+	ToFloat32Array() [3]float32
+	MortonCode(scale, offset T) uint64
+	ToEulerAngles() (pitch, yaw, roll T)
+	ToArray() [3]T
+	ToSlice() []T
+	ToVector3i() Vector3i
+	ToVector2() Vec2[T]
+	ToGLArray() [3]float32
 }
 
-// Vector3 represents a 3D vector with X, Y, and Z coordinates.
+// IVector3 is the interface for a 3D vector with float64 components.
+type IVector3 = IVec3[float64]
+
+// Vec3 represents a 3D vector with X, Y, and Z coordinates of any float type.
 // It provides methods for vector operations.
-type Vector3 struct {
-	X float64
-	Y float64
-	Z float64
+type Vec3[T Float] struct {
+	X T
+	Y T
+	Z T
 }
 
+// Vector3 is a 3D vector with float64 coordinates.
+type Vector3 = Vec3[float64]
+
+var (
+	_ IVec3[float32]             = (*Vec3[float32])(nil)
+	_ IVec3[float64]             = (*Vec3[float64])(nil)
+	_ encoding.BinaryMarshaler   = Vec3[float64]{}
+	_ encoding.BinaryUnmarshaler = (*Vec3[float64])(nil)
+	_ encoding.TextMarshaler     = Vec3[float64]{}
+	_ encoding.TextUnmarshaler   = (*Vec3[float64])(nil)
+)
+
+// NewVector3 creates a new 3D vector from its coordinates.
 func NewVector3(x, y, z float64) Vector3 {
 	return Vector3{
 		X: x,
@@ -43,69 +183,249 @@ func NewVector3(x, y, z float64) Vector3 {
 	}
 }
 
+// NewVec3 creates a new 3D vector of any float type from its coordinates.
+func NewVec3[T Float](x, y, z T) Vec3[T] {
+	return Vec3[T]{
+		X: x,
+		Y: y,
+		Z: z,
+	}
+}
+
+// Vec3FromVector3 converts a Vector3 to a vector of any float type, converting each component to T.
+func Vec3FromVector3[T Float](v Vector3) Vec3[T] {
+	return Vec3[T]{
+		X: T(v.X),
+		Y: T(v.Y),
+		Z: T(v.Z),
+	}
+}
+
+// Vector3FromVec3 converts a vector of any float type to a Vector3.
+func Vector3FromVec3[T Float](v Vec3[T]) Vector3 {
+	return Vector3{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+	}
+}
+
+// Vector3Zero returns a vector with all axes set to zero.
+func Vector3Zero() Vector3 {
+	return Vector3{X: 0, Y: 0, Z: 0}
+}
+
+// Vector3One returns a vector with all axes set to one.
+func Vector3One() Vector3 {
+	return Vector3{X: 1, Y: 1, Z: 1}
+}
+
+// Vector3Up returns a unit vector pointing along the positive Y axis.
+func Vector3Up() Vector3 {
+	return Vector3{X: 0, Y: 1, Z: 0}
+}
+
+// Vector3Right returns a unit vector pointing along the positive X axis.
+func Vector3Right() Vector3 {
+	return Vector3{X: 1, Y: 0, Z: 0}
+}
+
+// Vector3Forward returns a unit vector pointing along the negative Z axis.
+// This follows the right-handed, Y-up convention.
+func Vector3Forward() Vector3 {
+	return Vector3{X: 0, Y: 0, Z: -1}
+}
+
+// Vector3FromArray creates a new 3D vector from an array of its coordinates.
+func Vector3FromArray(a [3]float64) Vector3 {
+	return Vector3{X: a[0], Y: a[1], Z: a[2]}
+}
+
+// UnpackVector3Float32 decodes a vector from 12 bytes of little-endian IEEE 754 float32 values,
+// as produced by PackFloat32.
+func UnpackVector3Float32(b [12]byte) Vector3 {
+	return Vector3{
+		X: float64(math.Float32frombits(binary.LittleEndian.Uint32(b[0:]))),
+		Y: float64(math.Float32frombits(binary.LittleEndian.Uint32(b[4:]))),
+		Z: float64(math.Float32frombits(binary.LittleEndian.Uint32(b[8:]))),
+	}
+}
+
+// ParseVector3 parses a vector from comma-separated components, such as "(1.5, 2.3, 4)" or "1.5,2.3,4".
+// Surrounding whitespace and a pair of parentheses or brackets are allowed,
+// so the output of String can be parsed back.
+func ParseVector3(s string) (Vector3, error) {
+	var v Vector3
+
+	err := v.UnmarshalText([]byte(trimVectorDelimiters(s)))
+
+	if err != nil {
+		return Vector3{}, err
+	}
+
+	return v, nil
+}
+
+// Vector3FromEulerAngles creates a unit direction vector from a pitch and yaw in radians.
+// This follows the right-handed, Y-up convention, where a pitch and yaw of zero point forward along the negative Z axis,
+// a positive pitch points up, and a positive yaw rotates counterclockwise around the Y axis.
+func Vector3FromEulerAngles(pitch, yaw float64) Vector3 {
+	cosPitch := math.Cos(pitch)
+
+	return Vector3{
+		X: -math.Sin(yaw) * cosPitch,
+		Y: math.Sin(pitch),
+		Z: -math.Cos(yaw) * cosPitch,
+	}
+}
+
+// RandomVector3 creates a vector with each axis uniformly distributed in [minVal, maxVal).
+func RandomVector3(rng *rand.Rand, minVal, maxVal float64) Vector3 {
+	return Vector3{
+		X: minVal + rng.Float64()*(maxVal-minVal),
+		Y: minVal + rng.Float64()*(maxVal-minVal),
+		Z: minVal + rng.Float64()*(maxVal-minVal),
+	}
+}
+
+// RandomUnitVector3 creates a unit vector pointing in a uniformly random direction.
+// It uses the Marsaglia method, so the directions are evenly distributed over the sphere
+// without clustering around the poles.
+func RandomUnitVector3(rng *rand.Rand) Vector3 {
+	for {
+		a := rng.Float64()*2 - 1
+		b := rng.Float64()*2 - 1
+		s := a*a + b*b
+
+		if s >= 1 || s == 0 {
+			continue
+		}
+
+		scale := 2 * math.Sqrt(1-s)
+
+		return Vector3{
+			X: a * scale,
+			Y: b * scale,
+			Z: 1 - 2*s,
+		}
+	}
+}
+
+// AxisAngleToVector3 encodes a rotation around an axis by an angle in radians as a single vector,
+// whose direction is the axis and whose magnitude is the angle.
+// The axis is normalized first. If the axis is zero, the zero vector is returned.
+func AxisAngleToVector3(axis Vector3, angle float64) Vector3 {
+	axis.Normalize()
+	axis.Scale(angle)
+
+	return axis
+}
+
 // Add adds the values of another vector to this one.
-func (v *Vector3) Add(vec Vector3) {
+func (v *Vec3[T]) Add(vec Vec3[T]) {
 	v.X += vec.X
 	v.Y += vec.Y
 	v.Z += vec.Z
 }
 
+// Added returns a copy of this vector with the values of another vector added to it.
+func (v Vec3[T]) Added(vec Vec3[T]) Vec3[T] {
+	v.Add(vec)
+
+	return v
+}
+
 // Sub subtracts the values of another vector from this one.
-func (v *Vector3) Sub(vec Vector3) {
+func (v *Vec3[T]) Sub(vec Vec3[T]) {
 	v.X -= vec.X
 	v.Y -= vec.Y
 	v.Z -= vec.Z
 }
 
+// Subbed returns a copy of this vector with the values of another vector subtracted from it.
+func (v Vec3[T]) Subbed(vec Vec3[T]) Vec3[T] {
+	v.Sub(vec)
+
+	return v
+}
+
 // Mul multiplies this vector by another vector.
-func (v *Vector3) Mul(vec Vector3) {
+func (v *Vec3[T]) Mul(vec Vec3[T]) {
 	v.X *= vec.X
 	v.Y *= vec.Y
 	v.Z *= vec.Z
 }
 
+// Muled returns a copy of this vector multiplied by another vector.
+func (v Vec3[T]) Muled(vec Vec3[T]) Vec3[T] {
+	v.Mul(vec)
+
+	return v
+}
+
 // Div divides this vector by another vector.
-func (v *Vector3) Div(vec Vector3) {
+func (v *Vec3[T]) Div(vec Vec3[T]) {
 	v.X /= vec.X
 	v.Y /= vec.Y
 	v.Z /= vec.Z
 }
 
+// Dived returns a copy of this vector divided by another vector.
+func (v Vec3[T]) Dived(vec Vec3[T]) Vec3[T] {
+	v.Div(vec)
+
+	return v
+}
+
 // Scale multiplies this vector by a scale.
-func (v *Vector3) Scale(scale float64) {
+func (v *Vec3[T]) Scale(scale T) {
 	v.X *= scale
 	v.Y *= scale
 	v.Z *= scale
 }
 
+// Scaled returns a copy of this vector multiplied by a scale.
+func (v Vec3[T]) Scaled(scale T) Vec3[T] {
+	v.Scale(scale)
+
+	return v
+}
+
 // Bounce inverts the direction of the vector.
-func (v *Vector3) Bounce() {
+func (v *Vec3[T]) Bounce() {
 	v.X = -v.X
 	v.Y = -v.Y
 	v.Z = -v.Z
 }
 
 // Normalize scales the vector to have a magnitude of 1.
-func (v *Vector3) Normalize() {
+func (v *Vec3[T]) Normalize() {
 	magnitudeSquared := v.X*v.X + v.Y*v.Y + v.Z*v.Z
 
 	if magnitudeSquared != 0 {
-		magnitude := math.Sqrt(magnitudeSquared)
+		magnitude := sqrt(magnitudeSquared)
 		v.X /= magnitude
 		v.Y /= magnitude
 		v.Z /= magnitude
 	}
 }
 
+// Normalized returns a copy of this vector with a magnitude of 1.
+func (v Vec3[T]) Normalized() Vec3[T] {
+	v.Normalize()
+
+	return v
+}
+
 // AngleRadians returns the angle in radians.
-func (v Vector3) AngleRadians() float64 {
-	return math.Atan2(v.Y, v.X)
+func (v Vec3[T]) AngleRadians() T {
+	return atan2(v.Y, v.X)
 }
 
 // AngleDegrees returns the angle of the vector in degrees.
 // This method ignores the Z axis and projects the vector onto the XY plane.
-func (v Vector3) AngleDegrees() float64 {
-	angle := math.Atan2(v.Y, v.X) * 180 / math.Pi
+func (v Vec3[T]) AngleDegrees() T {
+	angle := atan2(v.Y, v.X) * 180 / math.Pi
 
 	if angle < 0 {
 		angle += 360
@@ -114,32 +434,79 @@ func (v Vector3) AngleDegrees() float64 {
 	return angle
 }
 
+// AngleBetween returns the unsigned angle between this vector and another vector in radians.
+// The result is in the range [0, π], or 0 if either vector is zero.
+func (v Vec3[T]) AngleBetween(vec Vec3[T]) T {
+	magnitudes := v.Magnitude() * vec.Magnitude()
+
+	if magnitudes == 0 {
+		return 0
+	}
+
+	cos := v.Dot(vec) / magnitudes
+
+	return acos(max(-1, min(1, cos)))
+}
+
+// AngleBetweenDegrees returns the unsigned angle between this vector and another vector in degrees.
+// The result is in the range [0, 180], or 0 if either vector is zero.
+func (v Vec3[T]) AngleBetweenDegrees(vec Vec3[T]) T {
+	return v.AngleBetween(vec) * 180 / math.Pi
+}
+
 // IsZero checks if all axes are zero.
-func (v Vector3) IsZero() bool {
+func (v Vec3[T]) IsZero() bool {
 	return v.X == 0 && v.Y == 0 && v.Z == 0
 }
 
+// IsNaN checks if any axis is NaN.
+func (v Vec3[T]) IsNaN() bool {
+	return isNaN(v.X) || isNaN(v.Y) || isNaN(v.Z)
+}
+
+// IsInf checks if any axis is positive or negative infinity.
+func (v Vec3[T]) IsInf() bool {
+	return isInf(v.X) || isInf(v.Y) || isInf(v.Z)
+}
+
+// IsFinite checks if all axes are neither NaN nor infinite.
+func (v Vec3[T]) IsFinite() bool {
+	return !v.IsNaN() && !v.IsInf()
+}
+
+// IsNormalized checks if the vector has a magnitude of 1, within NormalizationEpsilon,
+// or NormalizationEpsilon32 for float32 components.
+func (v Vec3[T]) IsNormalized() bool {
+	epsilon := NormalizationEpsilon
+
+	if bitSize[T]() == 32 {
+		epsilon = NormalizationEpsilon32
+	}
+
+	return math.Abs(float64(v.MagnitudeSquared())-1) < epsilon
+}
+
 // Magnitude returns the length of the vector.
-func (v Vector3) Magnitude() float64 {
-	return math.Sqrt((v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z))
+func (v Vec3[T]) Magnitude() T {
+	return sqrt((v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z))
 }
 
 // MagnitudeSquared returns the squared magnitude of the vector.
-func (v Vector3) MagnitudeSquared() float64 {
+func (v Vec3[T]) MagnitudeSquared() T {
 	return (v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z)
 }
 
 // Distance returns the distance between this vector and another vector.
-func (v Vector3) Distance(vec Vector3) float64 {
+func (v Vec3[T]) Distance(vec Vec3[T]) T {
 	dx := v.X - vec.X
 	dy := v.Y - vec.Y
 	dz := v.Z - vec.Z
 
-	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+	return sqrt(dx*dx + dy*dy + dz*dz)
 }
 
 // DistanceSquared returns the squared distance between this vector and another vector.
-func (v Vector3) DistanceSquared(vec Vector3) float64 {
+func (v Vec3[T]) DistanceSquared(vec Vec3[T]) T {
 	dx := v.X - vec.X
 	dy := v.Y - vec.Y
 	dz := v.Z - vec.Z
@@ -148,19 +515,42 @@ func (v Vector3) DistanceSquared(vec Vector3) float64 {
 
 // Dot returns the dot product.
 // Positive = same direction, negative = opposite, zero = perpendicular.
-func (v Vector3) Dot(vec Vector3) float64 {
+func (v Vec3[T]) Dot(vec Vec3[T]) T {
 	return v.X*vec.X + v.Y*vec.Y + v.Z*vec.Z
 }
 
+// Cross returns the cross product of this vector and another vector.
+// The result is perpendicular to both vectors.
+func (v Vec3[T]) Cross(vec Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: v.Y*vec.Z - v.Z*vec.Y,
+		Y: v.Z*vec.X - v.X*vec.Z,
+		Z: v.X*vec.Y - v.Y*vec.X,
+	}
+}
+
 // Lerp interpolates between this vector and another vector.
-func (v *Vector3) Lerp(vec Vector3, t float64) {
+// The t value is not clamped, so values outside [0, 1] extrapolate, the same as LerpUnclamped.
+func (v *Vec3[T]) Lerp(vec Vec3[T], t T) {
 	v.X += (vec.X - v.X) * t
 	v.Y += (vec.Y - v.Y) * t
 	v.Z += (vec.Z - v.Z) * t
 }
 
+// LerpUnclamped interpolates between this vector and a target vector.
+// Values of t outside [0, 1] extrapolate beyond this vector or the target.
+func (v *Vec3[T]) LerpUnclamped(target Vec3[T], t T) {
+	v.Lerp(target, t)
+}
+
+// LerpClamped interpolates between this vector and a target vector.
+// The t value is clamped to [0, 1], so the result never moves past the target.
+func (v *Vec3[T]) LerpClamped(target Vec3[T], t T) {
+	v.Lerp(target, max(0, min(1, t)))
+}
+
 // ClampMagnitude limits the magnitude of the vector to a maximum value.
-func (v *Vector3) ClampMagnitude(maxValue float64) {
+func (v *Vec3[T]) ClampMagnitude(maxValue T) {
 	maxSquared := maxValue * maxValue
 	magnitudeSquared := v.MagnitudeSquared()
 
@@ -168,23 +558,1354 @@ func (v *Vector3) ClampMagnitude(maxValue float64) {
 		return
 	}
 
-	scale := maxValue / math.Sqrt(magnitudeSquared)
+	scale := maxValue / sqrt(magnitudeSquared)
 	v.X *= scale
 	v.Y *= scale
 	v.Z *= scale
 }
 
 // Clear sets the vector to zero.
-func (v *Vector3) Clear() {
+func (v *Vec3[T]) Clear() {
 	v.X = 0
 	v.Y = 0
 	v.Z = 0
 }
 
-// ToVector2 converts the 3D vector to a 2D vector.
-func (v Vector3) ToVector2() Vector2 {
-	return Vector2{
-		X: v.X,
-		Y: v.Y,
+// Reflect reflects this vector across the plane defined by a normal.
+// The normal is assumed to be normalized.
+func (v *Vec3[T]) Reflect(normal Vec3[T]) {
+	dot := 2 * v.Dot(normal)
+	v.X -= dot * normal.X
+	v.Y -= dot * normal.Y
+	v.Z -= dot * normal.Z
+}
+
+// Project replaces this vector with its projection onto another vector.
+// If the other vector is zero, this vector is cleared.
+func (v *Vec3[T]) Project(onto Vec3[T]) {
+	ontoSquared := onto.MagnitudeSquared()
+
+	if ontoSquared == 0 {
+		v.Clear()
+
+		return
+	}
+
+	scale := v.Dot(onto) / ontoSquared
+	v.X = onto.X * scale
+	v.Y = onto.Y * scale
+	v.Z = onto.Z * scale
+}
+
+// Reject replaces this vector with the component of it that is perpendicular to another vector.
+func (v *Vec3[T]) Reject(from Vec3[T]) {
+	projection := *v
+	projection.Project(from)
+	v.Sub(projection)
+}
+
+// String returns the vector formatted as "(x, y, z)", such as "(1.5, 2.3, 4.0)".
+// Whole numbers are written with a trailing ".0", and the output can be parsed back with ParseVector3.
+func (v Vec3[T]) String() string {
+	return "(" + formatComponent(v.X) + ", " + formatComponent(v.Y) + ", " + formatComponent(v.Z) + ")"
+}
+
+// GoString returns the vector formatted as a Go expression, for use with %#v.
+func (v Vec3[T]) GoString() string {
+	return fmt.Sprintf("vectors.%s{X: %#v, Y: %#v, Z: %#v}", v.typeName(), v.X, v.Y, v.Z)
+}
+
+// typeName returns the name of the concrete vector type, for use in error messages.
+func (v Vec3[T]) typeName() string {
+	if bitSize[T]() == 32 {
+		return "Vector3f32"
+	}
+
+	return "Vector3"
+}
+
+type vec3JSON[T Float] struct {
+	X T `json:"x"`
+	Y T `json:"y"`
+	Z T `json:"z"`
+}
+
+// MarshalJSON encodes the vector as a JSON object with "x", "y", and "z" keys.
+func (v Vec3[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vec3JSON[T](v))
+}
+
+// UnmarshalJSON decodes the vector from a JSON object.
+// Keys are matched case-insensitively, so both "x" and "X" are accepted.
+func (v *Vec3[T]) UnmarshalJSON(data []byte) error {
+	var vec vec3JSON[T]
+
+	err := json.Unmarshal(data, &vec)
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s JSON: %w", v.typeName(), err)
+	}
+
+	*v = Vec3[T](vec)
+
+	return nil
+}
+
+// MarshalBinary encodes the vector as little-endian IEEE 754 values,
+// using 12 bytes for float32 components and 24 bytes for float64 components.
+func (v Vec3[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, 3*bitSize[T]()/8)
+	data = appendBinaryFloat(data, v.X)
+	data = appendBinaryFloat(data, v.Y)
+	data = appendBinaryFloat(data, v.Z)
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes the vector from little-endian IEEE 754 values,
+// using 12 bytes for float32 components and 24 bytes for float64 components.
+func (v *Vec3[T]) UnmarshalBinary(data []byte) error {
+	size := bitSize[T]() / 8
+
+	if len(data) != 3*size {
+		return fmt.Errorf("vectors: invalid %s binary length %d, expected %d", v.typeName(), len(data), 3*size)
+	}
+
+	v.X = readBinaryFloat[T](data[0:size])
+	v.Y = readBinaryFloat[T](data[size : 2*size])
+	v.Z = readBinaryFloat[T](data[2*size : 3*size])
+
+	return nil
+}
+
+// Equal checks if all axes are exactly equal to those of another vector.
+func (v Vec3[T]) Equal(vec Vec3[T]) bool {
+	return v.X == vec.X && v.Y == vec.Y && v.Z == vec.Z
+}
+
+// ApproxEqual checks if all axes differ from those of another vector by less than epsilon.
+func (v Vec3[T]) ApproxEqual(vec Vec3[T], epsilon T) bool {
+	return abs(v.X-vec.X) < epsilon &&
+		abs(v.Y-vec.Y) < epsilon &&
+		abs(v.Z-vec.Z) < epsilon
+}
+
+// Clamp limits each axis of the vector to the range of the same axis in two other vectors.
+// It panics if the minimum is greater than the maximum on any axis.
+func (v *Vec3[T]) Clamp(minValue, maxValue Vec3[T]) {
+	if minValue.X > maxValue.X {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum on the X axis")
+	}
+
+	if minValue.Y > maxValue.Y {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum on the Y axis")
+	}
+
+	if minValue.Z > maxValue.Z {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum on the Z axis")
+	}
+
+	v.X = max(minValue.X, min(maxValue.X, v.X))
+	v.Y = max(minValue.Y, min(maxValue.Y, v.Y))
+	v.Z = max(minValue.Z, min(maxValue.Z, v.Z))
+}
+
+// ClampScalar limits each axis of the vector to the same range.
+// It panics if the minimum is greater than the maximum.
+func (v *Vec3[T]) ClampScalar(minValue, maxValue T) {
+	if minValue > maxValue {
+		panic("vectors: " + v.typeName() + " clamp minimum is greater than maximum")
+	}
+
+	v.X = max(minValue, min(maxValue, v.X))
+	v.Y = max(minValue, min(maxValue, v.Y))
+	v.Z = max(minValue, min(maxValue, v.Z))
+}
+
+// Abs replaces each axis of the vector with its absolute value.
+func (v *Vec3[T]) Abs() {
+	v.X = abs(v.X)
+	v.Y = abs(v.Y)
+	v.Z = abs(v.Z)
+}
+
+// Absed returns a copy of this vector with each axis replaced by its absolute value.
+func (v Vec3[T]) Absed() Vec3[T] {
+	v.Abs()
+
+	return v
+}
+
+// Floor rounds each axis of the vector down to the nearest integer.
+func (v *Vec3[T]) Floor() {
+	v.X = floor(v.X)
+	v.Y = floor(v.Y)
+	v.Z = floor(v.Z)
+}
+
+// Ceil rounds each axis of the vector up to the nearest integer.
+func (v *Vec3[T]) Ceil() {
+	v.X = ceil(v.X)
+	v.Y = ceil(v.Y)
+	v.Z = ceil(v.Z)
+}
+
+// Round rounds each axis of the vector to the nearest integer, rounding half away from zero.
+func (v *Vec3[T]) Round() {
+	v.X = round(v.X)
+	v.Y = round(v.Y)
+	v.Z = round(v.Z)
+}
+
+// Trunc removes the fractional part of each axis of the vector.
+func (v *Vec3[T]) Trunc() {
+	v.X = trunc(v.X)
+	v.Y = trunc(v.Y)
+	v.Z = trunc(v.Z)
+}
+
+// ComponentMin sets each axis of the vector to the minimum of itself and the same axis of another vector.
+func (v *Vec3[T]) ComponentMin(vec Vec3[T]) {
+	v.X = min(v.X, vec.X)
+	v.Y = min(v.Y, vec.Y)
+	v.Z = min(v.Z, vec.Z)
+}
+
+// ComponentMax sets each axis of the vector to the maximum of itself and the same axis of another vector.
+func (v *Vec3[T]) ComponentMax(vec Vec3[T]) {
+	v.X = max(v.X, vec.X)
+	v.Y = max(v.Y, vec.Y)
+	v.Z = max(v.Z, vec.Z)
+}
+
+// ComponentMinVector3 returns a new vector with the minimum of each axis of two vectors.
+func ComponentMinVector3(a, b Vector3) Vector3 {
+	a.ComponentMin(b)
+
+	return a
+}
+
+// ComponentMaxVector3 returns a new vector with the maximum of each axis of two vectors.
+func ComponentMaxVector3(a, b Vector3) Vector3 {
+	a.ComponentMax(b)
+
+	return a
+}
+
+// ZipVector3 returns a new vector with a function applied to each pair of axes of two vectors,
+// such as ZipVector3(a, b, math.Max).
+func ZipVector3(a, b Vector3, f func(float64, float64) float64) Vector3 {
+	return Vector3{X: f(a.X, b.X), Y: f(a.Y, b.Y), Z: f(a.Z, b.Z)}
+}
+
+// SumVector3 returns the sum of all vectors in a slice.
+// It returns the zero vector for an empty slice.
+func SumVector3(vecs []Vector3) Vector3 {
+	var sum Vector3
+
+	for _, vec := range vecs {
+		sum.Add(vec)
+	}
+
+	return sum
+}
+
+// AverageVector3 returns the average of all vectors in a slice, such as the centroid of a set of points.
+// It returns the zero vector for an empty slice.
+func AverageVector3(vecs []Vector3) Vector3 {
+	if len(vecs) == 0 {
+		return Vector3{}
+	}
+
+	sum := SumVector3(vecs)
+	sum.Scale(1 / float64(len(vecs)))
+
+	return sum
+}
+
+// WeightedAverageVector3 returns the sum of each vector multiplied by its weight, divided by the sum of the weights.
+// It returns ErrLengthMismatch if the slices have different lengths,
+// and ErrZeroTotalWeight if the weights sum to zero.
+// This covers empty slices and all-zero weights, as well as non-zero weights that cancel out, such as [1, -1].
+func WeightedAverageVector3(vecs []Vector3, weights []float64) (Vector3, error) {
+	if len(vecs) != len(weights) {
+		return Vector3{}, fmt.Errorf("%w: %d vectors and %d weights", ErrLengthMismatch, len(vecs), len(weights))
+	}
+
+	var sum Vector3
+	var totalWeight float64
+
+	for i, vec := range vecs {
+		sum.Add(vec.Scaled(weights[i]))
+		totalWeight += weights[i]
+	}
+
+	if totalWeight == 0 {
+		return Vector3{}, ErrZeroTotalWeight
+	}
+
+	sum.Scale(1 / totalWeight)
+
+	return sum, nil
+}
+
+// BiLerpVector3 bilinearly interpolates between the four corners of a quad patch.
+// The corners a, b, c, and d are at (s, t) = (0, 0), (1, 0), (0, 1), and (1, 1) respectively.
+func BiLerpVector3(a, b, c, d Vector3, s, t float64) Vector3 {
+	a.Lerp(b, s)
+	c.Lerp(d, s)
+	a.Lerp(c, t)
+
+	return a
+}
+
+// Slerp spherically interpolates between the direction of this vector and the direction of a target vector.
+// Both vectors are normalized first, so the result is always a unit vector, or zero if either vector is zero.
+// For antiparallel vectors, the rotation happens around an arbitrary axis perpendicular to this vector.
+func (v *Vec3[T]) Slerp(target Vec3[T], t T) {
+	from := v.Normalized()
+	to := target.Normalized()
+
+	if from.IsZero() || to.IsZero() {
+		v.Clear()
+
+		return
+	}
+
+	cosTheta := max(-1, min(1, from.Dot(to)))
+	theta := acos(cosTheta)
+	sinTheta := sin(theta)
+
+	if sinTheta < 1e-9 && cosTheta > 0 {
+		from.Lerp(to, t)
+		from.Normalize()
+		*v = from
+
+		return
+	}
+
+	if sinTheta < 1e-9 {
+		axis := from.Cross(Vec3[T]{X: 1, Y: 0, Z: 0})
+
+		if axis.MagnitudeSquared() < 1e-9 {
+			axis = from.Cross(Vec3[T]{X: 0, Y: 1, Z: 0})
+		}
+
+		axis.Normalize()
+		perpendicular := axis.Cross(from)
+		angle := t * math.Pi
+
+		from.Scale(cos(angle))
+		perpendicular.Scale(sin(angle))
+		from.Add(perpendicular)
+		*v = from
+
+		return
+	}
+
+	fromWeight := sin((1-t)*theta) / sinTheta
+	toWeight := sin(t*theta) / sinTheta
+
+	from.Scale(fromWeight)
+	to.Scale(toWeight)
+	from.Add(to)
+	*v = from
+}
+
+// RotateAroundAxis rotates the vector around an axis by an angle in radians, using the Rodrigues rotation formula.
+// The axis is normalized internally. If the axis is zero, the vector is left unchanged.
+func (v *Vec3[T]) RotateAroundAxis(axis Vec3[T], radians T) {
+	axis.Normalize()
+
+	if axis.IsZero() {
+		return
+	}
+
+	sin, cos := sincos(radians)
+
+	cross := axis.Cross(*v)
+	cross.Scale(sin)
+
+	parallel := axis.Scaled(axis.Dot(*v) * (1 - cos))
+
+	v.Scale(cos)
+	v.Add(cross)
+	v.Add(parallel)
+}
+
+// MoveTowards moves the vector towards a target by at most maxDelta units.
+// The vector stops exactly at the target instead of overshooting it.
+func (v *Vec3[T]) MoveTowards(target Vec3[T], maxDelta T) {
+	delta := target.Subbed(*v)
+	distance := delta.Magnitude()
+
+	if distance <= maxDelta || distance == 0 {
+		*v = target
+
+		return
+	}
+
+	delta.Scale(maxDelta / distance)
+	v.Add(delta)
+}
+
+// SmoothStep interpolates between this vector and a target vector using the cubic 3t²-2t³ curve.
+// The value of t is clamped to the range [0, 1].
+func (v *Vec3[T]) SmoothStep(target Vec3[T], t T) {
+	if t >= 1 {
+		*v = target
+
+		return
+	}
+
+	t = max(0, t)
+	v.Lerp(target, t*t*(3-2*t))
+}
+
+// SmootherStep interpolates between this vector and a target vector using the quintic 6t⁵-15t⁴+10t³ curve.
+// The value of t is clamped to the range [0, 1].
+func (v *Vec3[T]) SmootherStep(target Vec3[T], t T) {
+	if t >= 1 {
+		*v = target
+
+		return
+	}
+
+	t = max(0, t)
+	v.Lerp(target, t*t*t*(t*(t*6-15)+10))
+}
+
+// SafeDiv divides this vector by another vector.
+// It returns an error naming the axis if any axis of the other vector is zero, leaving this vector unchanged.
+func (v *Vec3[T]) SafeDiv(vec Vec3[T]) error {
+	if vec.X == 0 {
+		return fmt.Errorf("%w on the X axis", ErrDivisionByZero)
 	}
+
+	if vec.Y == 0 {
+		return fmt.Errorf("%w on the Y axis", ErrDivisionByZero)
+	}
+
+	if vec.Z == 0 {
+		return fmt.Errorf("%w on the Z axis", ErrDivisionByZero)
+	}
+
+	v.Div(vec)
+
+	return nil
+}
+
+// ClampMagnitudeRange limits the magnitude of the vector to the range [minValue, maxValue].
+// A zero vector has no direction, so it is left unchanged even if minValue is greater than zero.
+// It panics if minValue is greater than maxValue.
+func (v *Vec3[T]) ClampMagnitudeRange(minValue, maxValue T) {
+	if minValue > maxValue {
+		panic("vectors: " + v.typeName() + " clamp magnitude minimum is greater than maximum")
+	}
+
+	magnitudeSquared := v.MagnitudeSquared()
+
+	if magnitudeSquared == 0 {
+		return
+	}
+
+	if magnitudeSquared < minValue*minValue {
+		v.Scale(minValue / sqrt(magnitudeSquared))
+
+		return
+	}
+
+	v.ClampMagnitude(maxValue)
+}
+
+// SetMagnitude scales the vector to have a specific magnitude while keeping its direction.
+// A zero vector has no direction, so it is left unchanged.
+func (v *Vec3[T]) SetMagnitude(magnitude T) {
+	v.Normalize()
+	v.Scale(magnitude)
+}
+
+// IsParallel checks if the magnitude of the cross product with another vector is less than epsilon.
+// This includes vectors pointing in opposite directions.
+func (v Vec3[T]) IsParallel(vec Vec3[T], epsilon T) bool {
+	return v.Cross(vec).Magnitude() < epsilon
+}
+
+// IsPerpendicular checks if the absolute dot product with another vector is less than epsilon.
+func (v Vec3[T]) IsPerpendicular(vec Vec3[T], epsilon T) bool {
+	return abs(v.Dot(vec)) < epsilon
+}
+
+// Clone returns a copy of the vector.
+func (v Vec3[T]) Clone() Vec3[T] {
+	return v
+}
+
+// SnapToGrid rounds each axis of the vector to the nearest multiple of a grid size.
+// It panics if the grid size is zero.
+func (v *Vec3[T]) SnapToGrid(gridSize T) {
+	v.SnapToGridXYZ(gridSize, gridSize, gridSize)
+}
+
+// SnapToGridXYZ rounds each axis of the vector to the nearest multiple of a separate grid size per axis.
+// It panics if any grid size is zero.
+func (v *Vec3[T]) SnapToGridXYZ(gridX, gridY, gridZ T) {
+	if gridX == 0 || gridY == 0 || gridZ == 0 {
+		panic("vectors: " + v.typeName() + " grid size must not be zero")
+	}
+
+	v.X = round(v.X/gridX) * gridX
+	v.Y = round(v.Y/gridY) * gridY
+	v.Z = round(v.Z/gridZ) * gridZ
+}
+
+// ComponentSum returns the sum of all axes.
+func (v Vec3[T]) ComponentSum() T {
+	return v.X + v.Y + v.Z
+}
+
+// ComponentProduct returns the product of all axes.
+func (v Vec3[T]) ComponentProduct() T {
+	return v.X * v.Y * v.Z
+}
+
+// MaxComponent returns the value of the largest axis.
+func (v Vec3[T]) MaxComponent() T {
+	return max(v.X, max(v.Y, v.Z))
+}
+
+// MinComponent returns the value of the smallest axis.
+func (v Vec3[T]) MinComponent() T {
+	return min(v.X, min(v.Y, v.Z))
+}
+
+// MaxComponentIndex returns the index of the largest axis, where 0 = X, 1 = Y, and 2 = Z.
+// If multiple axes are equal, the lowest index is returned.
+func (v Vec3[T]) MaxComponentIndex() int {
+	index := 0
+	value := v.X
+
+	if v.Y > value {
+		index = 1
+		value = v.Y
+	}
+
+	if v.Z > value {
+		index = 2
+	}
+
+	return index
+}
+
+// MinComponentIndex returns the index of the smallest axis, where 0 = X, 1 = Y, and 2 = Z.
+// If multiple axes are equal, the lowest index is returned.
+func (v Vec3[T]) MinComponentIndex() int {
+	index := 0
+	value := v.X
+
+	if v.Y < value {
+		index = 1
+		value = v.Y
+	}
+
+	if v.Z < value {
+		index = 2
+	}
+
+	return index
+}
+
+// SafeNormalize scales the vector to have a magnitude of 1.
+// If the vector is zero, it is set to the fallback instead, which is assumed to be normalized.
+func (v *Vec3[T]) SafeNormalize(fallback Vec3[T]) {
+	if v.IsZero() {
+		*v = fallback
+
+		return
+	}
+
+	v.Normalize()
+}
+
+// MarshalText encodes the vector as text in the "x,y,z" format.
+func (v Vec3[T]) MarshalText() ([]byte, error) {
+	var data []byte
+	data = strconv.AppendFloat(data, float64(v.X), 'g', -1, bitSize[T]())
+	data = append(data, ',')
+	data = strconv.AppendFloat(data, float64(v.Y), 'g', -1, bitSize[T]())
+	data = append(data, ',')
+	data = strconv.AppendFloat(data, float64(v.Z), 'g', -1, bitSize[T]())
+
+	return data, nil
+}
+
+// UnmarshalText decodes the vector from text in the "x,y,z" format.
+func (v *Vec3[T]) UnmarshalText(data []byte) error {
+	parts := strings.Split(string(data), ",")
+
+	if len(parts) != 3 {
+		return fmt.Errorf("vectors: invalid %s text %q, expected 3 components", v.typeName(), data)
+	}
+
+	x, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), bitSize[T]())
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s X component: %w", v.typeName(), err)
+	}
+
+	y, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), bitSize[T]())
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s Y component: %w", v.typeName(), err)
+	}
+
+	z, err := strconv.ParseFloat(strings.TrimSpace(parts[2]), bitSize[T]())
+
+	if err != nil {
+		return fmt.Errorf("vectors: invalid %s Z component: %w", v.typeName(), err)
+	}
+
+	v.X = T(x)
+	v.Y = T(y)
+	v.Z = T(z)
+
+	return nil
+}
+
+// ManhattanDistance returns the L1 distance between this vector and another vector,
+// which is the sum of the absolute differences of each axis.
+func (v Vec3[T]) ManhattanDistance(vec Vec3[T]) T {
+	return abs(v.X-vec.X) + abs(v.Y-vec.Y) + abs(v.Z-vec.Z)
+}
+
+// ChebyshevDistance returns the L∞ distance between this vector and another vector,
+// which is the largest absolute difference of any axis.
+func (v Vec3[T]) ChebyshevDistance(vec Vec3[T]) T {
+	return max(abs(v.X-vec.X), max(abs(v.Y-vec.Y), abs(v.Z-vec.Z)))
+}
+
+// MinkowskiDistance returns the Lp distance between this vector and another vector.
+// A p of 1 is equal to ManhattanDistance and a p of 2 is equal to Distance.
+func (v Vec3[T]) MinkowskiDistance(vec Vec3[T], p T) T {
+	return pow(
+		pow(abs(v.X-vec.X), p)+pow(abs(v.Y-vec.Y), p)+pow(abs(v.Z-vec.Z), p),
+		1/p,
+	)
+}
+
+// CosineSimilarity returns the cosine of the angle between this vector and another vector.
+// The result is 1 for the same direction, -1 for opposite directions, and 0 for perpendicular vectors.
+// It returns 0 if either vector is zero.
+func (v Vec3[T]) CosineSimilarity(vec Vec3[T]) T {
+	magnitudes := v.Magnitude() * vec.Magnitude()
+
+	if magnitudes == 0 {
+		return 0
+	}
+
+	return v.Dot(vec) / magnitudes
+}
+
+// Wrap wraps each axis of the vector into the range [minValue, maxValue) of the same axis,
+// as in a toroidal world. If an axis has an empty range, it is set to the minimum of that axis.
+func (v *Vec3[T]) Wrap(minValue, maxValue Vec3[T]) {
+	v.X = wrapFloat(v.X, minValue.X, maxValue.X)
+	v.Y = wrapFloat(v.Y, minValue.Y, maxValue.Y)
+	v.Z = wrapFloat(v.Z, minValue.Z, maxValue.Z)
+}
+
+// WrapScalar wraps each axis of the vector into the range [minValue, maxValue).
+// If the range is empty, all axes are set to minValue.
+func (v *Vec3[T]) WrapScalar(minValue, maxValue T) {
+	v.X = wrapFloat(v.X, minValue, maxValue)
+	v.Y = wrapFloat(v.Y, minValue, maxValue)
+	v.Z = wrapFloat(v.Z, minValue, maxValue)
+}
+
+// ToroidalDistance returns the shortest distance between this vector and another vector
+// in a world that wraps around at the given size on each axis.
+// Each axis of the size must be positive.
+func (v Vec3[T]) ToroidalDistance(vec, size Vec3[T]) T {
+	dx := toroidalDelta(v.X, vec.X, size.X)
+	dy := toroidalDelta(v.Y, vec.Y, size.Y)
+	dz := toroidalDelta(v.Z, vec.Z, size.Z)
+
+	return sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+// ClosestPointOnSegment returns the point on the line segment from a to b that is closest to this vector.
+// If a and b are equal, a is returned.
+func (v Vec3[T]) ClosestPointOnSegment(a, b Vec3[T]) Vec3[T] {
+	segment := b.Subbed(a)
+	lengthSquared := segment.MagnitudeSquared()
+
+	if lengthSquared == 0 {
+		return a
+	}
+
+	t := v.Subbed(a).Dot(segment) / lengthSquared
+	t = max(0, min(1, t))
+
+	return a.Added(segment.Scaled(t))
+}
+
+// DistanceToSegment returns the distance from this vector to the closest point on the line segment from a to b.
+// If a and b are equal, the distance to a is returned.
+func (v Vec3[T]) DistanceToSegment(a, b Vec3[T]) T {
+	return v.Distance(v.ClosestPointOnSegment(a, b))
+}
+
+// Get returns the axis at an index, where 0 = X, 1 = Y, and 2 = Z.
+// It panics if the index is out of range.
+func (v Vec3[T]) Get(i int) T {
+	switch i {
+	case 0:
+		return v.X
+	case 1:
+		return v.Y
+	case 2:
+		return v.Z
+	}
+
+	panic(fmt.Sprintf("vectors: %s index %d out of range", v.typeName(), i))
+}
+
+// Set sets the axis at an index, where 0 = X, 1 = Y, and 2 = Z.
+// It panics if the index is out of range.
+func (v *Vec3[T]) Set(i int, val T) {
+	switch i {
+	case 0:
+		v.X = val
+	case 1:
+		v.Y = val
+	case 2:
+		v.Z = val
+	default:
+		panic(fmt.Sprintf("vectors: %s index %d out of range", v.typeName(), i))
+	}
+}
+
+// Len returns the number of axes, which is always 3.
+func (v Vec3[T]) Len() int {
+	return 3
+}
+
+// Components returns the axes of the vector in order as a newly allocated slice.
+func (v Vec3[T]) Components() []T {
+	return v.ToSlice()
+}
+
+// Map returns a new vector with a function applied to each axis, such as v.Map(math.Abs).
+func (v Vec3[T]) Map(f func(T) T) Vec3[T] {
+	return Vec3[T]{X: f(v.X), Y: f(v.Y), Z: f(v.Z)}
+}
+
+// Saturate clamps each axis of the vector to [0, 1].
+func (v *Vec3[T]) Saturate() {
+	v.ClampScalar(0, 1)
+}
+
+// Saturated returns a copy of this vector with each axis clamped to [0, 1].
+func (v Vec3[T]) Saturated() Vec3[T] {
+	v.Saturate()
+
+	return v
+}
+
+// Fract replaces each axis of the vector with its fractional part, which is always in [0, 1).
+// Negative values wrap upward, so -0.25 becomes 0.75.
+func (v *Vec3[T]) Fract() {
+	v.X -= floor(v.X)
+	v.Y -= floor(v.Y)
+	v.Z -= floor(v.Z)
+}
+
+// Fracted returns a copy of this vector with each axis replaced by its fractional part.
+func (v Vec3[T]) Fracted() Vec3[T] {
+	v.Fract()
+
+	return v
+}
+
+// Step returns a new vector with each axis set to 0 if it is less than the same axis of an edge, and 1 otherwise,
+// like the GLSL step function.
+func (v Vec3[T]) Step(edge Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: stepFloat(edge.X, v.X),
+		Y: stepFloat(edge.Y, v.Y),
+		Z: stepFloat(edge.Z, v.Z),
+	}
+}
+
+// SmoothStepComponent returns a new vector with each axis smoothly mapped from the range between two edges to [0, 1]
+// using the cubic 3t²-2t³ curve, like the GLSL smoothstep function.
+// Axes at or below edge0 become 0 and axes at or above edge1 become 1.
+// If both edges are equal on an axis, that axis behaves like Step.
+func (v Vec3[T]) SmoothStepComponent(edge0, edge1 Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: smoothStepFloat(edge0.X, edge1.X, v.X),
+		Y: smoothStepFloat(edge0.Y, edge1.Y, v.Y),
+		Z: smoothStepFloat(edge0.Z, edge1.Z, v.Z),
+	}
+}
+
+// Quantize rounds each axis of the vector to a number of decimal places.
+// A precision of 0 rounds to integers, and a negative precision rounds to tens, hundreds, and so on,
+// so a precision of -2 rounds to the nearest multiple of 100.
+// An axis is left unchanged if 10^precision or the scaled axis cannot be represented in the vector's float type.
+func (v *Vec3[T]) Quantize(precision int) {
+	scale := T(math.Pow(10, float64(precision)))
+
+	v.X = quantizeFloat(v.X, scale)
+	v.Y = quantizeFloat(v.Y, scale)
+	v.Z = quantizeFloat(v.Z, scale)
+}
+
+// QuantizeToStep rounds each axis of the vector to the nearest multiple of a step, such as 0.25.
+// It panics if the step is zero.
+func (v *Vec3[T]) QuantizeToStep(step T) {
+	v.SnapToGrid(step)
+}
+
+// ReflectWithRestitution reflects this vector across the plane defined by a normal, scaling the bounce by a restitution.
+// A restitution of 1 is a perfect bounce like Reflect, and 0 removes the normal component so the vector slides along the plane.
+// The normal is assumed to be normalized.
+func (v *Vec3[T]) ReflectWithRestitution(normal Vec3[T], restitution T) {
+	v.Sub(normal.Scaled((1 + restitution) * v.Dot(normal)))
+}
+
+// Hash returns the 64-bit FNV-1a hash of the little-endian bit patterns of the axes.
+// Negative zero is hashed as zero, so equal vectors always have equal hashes.
+func (v Vec3[T]) Hash() uint64 {
+	if v.X == 0 {
+		v.X = 0
+	}
+
+	if v.Y == 0 {
+		v.Y = 0
+	}
+
+	if v.Z == 0 {
+		v.Z = 0
+	}
+
+	data, _ := v.MarshalBinary()
+
+	hash := fnv.New64a()
+	_, _ = hash.Write(data)
+
+	return hash.Sum64()
+}
+
+// QuantizedHash returns the hash of the vector after rounding each axis to the nearest multiple of a step,
+// so nearly equal vectors can share a hash bucket.
+// It panics if the step is zero.
+func (v Vec3[T]) QuantizedHash(step T) uint64 {
+	v.QuantizeToStep(step)
+
+	return v.Hash()
+}
+
+// DirectionTo returns the unit vector pointing from this vector to a target.
+// If both vectors are equal, the zero vector is returned.
+func (v Vec3[T]) DirectionTo(target Vec3[T]) Vec3[T] {
+	direction := target.Subbed(v)
+	direction.Normalize()
+
+	return direction
+}
+
+// DirectionFrom returns the unit vector pointing from a source to this vector.
+// If both vectors are equal, the zero vector is returned.
+func (v Vec3[T]) DirectionFrom(source Vec3[T]) Vec3[T] {
+	direction := v.Subbed(source)
+	direction.Normalize()
+
+	return direction
+}
+
+// XY returns a 2D vector with the X and Y axes of this vector, in that order.
+func (v Vec3[T]) XY() Vec2[T] {
+	return Vec2[T]{X: v.X, Y: v.Y}
+}
+
+// XZ returns a 2D vector with the X and Z axes of this vector, in that order.
+func (v Vec3[T]) XZ() Vec2[T] {
+	return Vec2[T]{X: v.X, Y: v.Z}
+}
+
+// YZ returns a 2D vector with the Y and Z axes of this vector, in that order.
+func (v Vec3[T]) YZ() Vec2[T] {
+	return Vec2[T]{X: v.Y, Y: v.Z}
+}
+
+// YX returns a 2D vector with the Y and X axes of this vector, in that order.
+func (v Vec3[T]) YX() Vec2[T] {
+	return Vec2[T]{X: v.Y, Y: v.X}
+}
+
+// ZX returns a 2D vector with the Z and X axes of this vector, in that order.
+func (v Vec3[T]) ZX() Vec2[T] {
+	return Vec2[T]{X: v.Z, Y: v.X}
+}
+
+// ZY returns a 2D vector with the Z and Y axes of this vector, in that order.
+func (v Vec3[T]) ZY() Vec2[T] {
+	return Vec2[T]{X: v.Z, Y: v.Y}
+}
+
+// ProjectOntoPlane projects this vector onto the plane through the origin defined by a normal,
+// removing the component of the vector that is parallel to the normal.
+// The normal is assumed to be normalized.
+func (v *Vec3[T]) ProjectOntoPlane(planeNormal Vec3[T]) {
+	v.Sub(planeNormal.Scaled(v.Dot(planeNormal)))
+}
+
+// ProjectedOntoPlane returns a copy of this vector projected onto the plane through the origin defined by a normal.
+// The normal is assumed to be normalized.
+func (v Vec3[T]) ProjectedOntoPlane(planeNormal Vec3[T]) Vec3[T] {
+	v.ProjectOntoPlane(planeNormal)
+
+	return v
+}
+
+// ScaleAround scales the distance of the vector from a pivot point, keeping its direction from the pivot.
+func (v *Vec3[T]) ScaleAround(pivot Vec3[T], scale T) {
+	v.Sub(pivot)
+	v.Scale(scale)
+	v.Add(pivot)
+}
+
+// ScaleAroundNonUniform scales the offset of the vector from a pivot point by a separate scale per axis.
+func (v *Vec3[T]) ScaleAroundNonUniform(pivot, scale Vec3[T]) {
+	v.Sub(pivot)
+	v.Mul(scale)
+	v.Add(pivot)
+}
+
+// Reciprocal replaces each axis of the vector with 1 divided by that axis.
+// Zero axes become infinite, following the usual IEEE 754 semantics.
+func (v *Vec3[T]) Reciprocal() {
+	v.X = 1 / v.X
+	v.Y = 1 / v.Y
+	v.Z = 1 / v.Z
+}
+
+// RecipMul returns a copy of this vector multiplied by the reciprocal of each axis of another vector.
+// Zero axes of the other vector produce infinite or NaN axes, following the usual IEEE 754 semantics.
+func (v Vec3[T]) RecipMul(vec Vec3[T]) Vec3[T] {
+	vec.Reciprocal()
+	v.Mul(vec)
+
+	return v
+}
+
+// Decompose splits the vector into a component parallel to an axis and a component perpendicular to it,
+// which add up to the original vector.
+// The axis does not need to be normalized. If the axis is zero, the whole vector is perpendicular.
+func (v Vec3[T]) Decompose(axis Vec3[T]) (parallel, perpendicular Vec3[T]) {
+	parallel = v
+	parallel.Project(axis)
+
+	return parallel, v.Subbed(parallel)
+}
+
+// ToAxisAngle interprets the vector as a rotation in axis-angle form,
+// where its direction is the rotation axis and its magnitude is the angle in radians.
+// It returns the unit axis and the angle. A zero vector is no rotation, with a zero axis and angle.
+func (v Vec3[T]) ToAxisAngle() (axis Vec3[T], angleRadians T) {
+	return v.Normalized(), v.Magnitude()
+}
+
+// Midpoint returns the point halfway between this vector and another vector.
+// It is computed as (v + vec) * 0.5, which stays finite for components of opposite sign near the float limits.
+func (v Vec3[T]) Midpoint(vec Vec3[T]) Vec3[T] {
+	return Vec3[T]{
+		X: (v.X + vec.X) * 0.5,
+		Y: (v.Y + vec.Y) * 0.5,
+		Z: (v.Z + vec.Z) * 0.5,
+	}
+}
+
+// WeightedMidpoint returns the point between this vector and another vector at a weight,
+// where a weight of 0 returns this vector and a weight of 1 returns the other vector.
+func (v Vec3[T]) WeightedMidpoint(vec Vec3[T], weight T) Vec3[T] {
+	v.Lerp(vec, weight)
+
+	return v
+}
+
+// IsInSphere checks if the vector lies inside a sphere, including its boundary.
+func (v Vec3[T]) IsInSphere(center Vec3[T], radius T) bool {
+	return v.DistanceSquared(center) <= radius*radius
+}
+
+// IsOnSphere checks if the distance of the vector from the center of a sphere differs from its radius by less than epsilon.
+func (v Vec3[T]) IsOnSphere(center Vec3[T], radius, epsilon T) bool {
+	return abs(v.Distance(center)-radius) < epsilon
+}
+
+// IsInAABB checks if the vector lies inside the axis-aligned bounding box from minValue to maxValue,
+// including its boundary. If the minimum is greater than the maximum on any axis, it returns false.
+func (v Vec3[T]) IsInAABB(minValue, maxValue Vec3[T]) bool {
+	return v.X >= minValue.X && v.X <= maxValue.X &&
+		v.Y >= minValue.Y && v.Y <= maxValue.Y &&
+		v.Z >= minValue.Z && v.Z <= maxValue.Z
+}
+
+// IsInAABBExclusive checks if the vector lies strictly inside the axis-aligned bounding box from minValue to maxValue,
+// excluding its boundary. If the minimum is greater than the maximum on any axis, it returns false.
+func (v Vec3[T]) IsInAABBExclusive(minValue, maxValue Vec3[T]) bool {
+	return v.X > minValue.X && v.X < maxValue.X &&
+		v.Y > minValue.Y && v.Y < maxValue.Y &&
+		v.Z > minValue.Z && v.Z < maxValue.Z
+}
+
+// OrthogonalVector returns a unit vector that is perpendicular to this vector.
+// It uses the Hughes-Möller method, which avoids precision loss near the coordinate axes.
+// If the vector has a magnitude of 0, a zero vector is returned.
+func (v Vec3[T]) OrthogonalVector() Vec3[T] {
+	x, y, z := abs(v.X), abs(v.Y), abs(v.Z)
+
+	var orthogonal Vec3[T]
+
+	switch {
+	case x <= y && x <= z:
+		orthogonal = Vec3[T]{X: 0, Y: -v.Z, Z: v.Y}
+	case y <= z:
+		orthogonal = Vec3[T]{X: -v.Z, Y: 0, Z: v.X}
+	default:
+		orthogonal = Vec3[T]{X: -v.Y, Y: v.X, Z: 0}
+	}
+
+	return orthogonal.Normalized()
+}
+
+// NearestPointOnLine returns the point on the infinite line through linePoint along lineDir that is closest to this vector.
+// The direction does not need to be normalized. If lineDir has a magnitude of 0, linePoint is returned.
+func (v Vec3[T]) NearestPointOnLine(linePoint, lineDir Vec3[T]) Vec3[T] {
+	lengthSquared := lineDir.MagnitudeSquared()
+
+	if lengthSquared == 0 {
+		return linePoint
+	}
+
+	t := v.Subbed(linePoint).Dot(lineDir) / lengthSquared
+
+	return linePoint.Added(lineDir.Scaled(t))
+}
+
+// DistanceToLine returns the distance from this vector to the infinite line through linePoint along lineDir.
+// If lineDir has a magnitude of 0, the distance to linePoint is returned.
+func (v Vec3[T]) DistanceToLine(linePoint, lineDir Vec3[T]) T {
+	return v.Distance(v.NearestPointOnLine(linePoint, lineDir))
+}
+
+// PackFloat32 encodes the vector as 12 bytes of little-endian IEEE 754 float32 values,
+// matching the layout of a float32 vertex attribute. Precision beyond float32 is lost.
+func (v Vec3[T]) PackFloat32() [12]byte {
+	var b [12]byte
+
+	binary.LittleEndian.PutUint32(b[0:], math.Float32bits(float32(v.X)))
+	binary.LittleEndian.PutUint32(b[4:], math.Float32bits(float32(v.Y)))
+	binary.LittleEndian.PutUint32(b[8:], math.Float32bits(float32(v.Z)))
+
+	return b
+}
+
+// ToFloat32Array returns the coordinates of the vector as an array of float32 values.
+func (v Vec3[T]) ToFloat32Array() [3]float32 {
+	return [3]float32{float32(v.X), float32(v.Y), float32(v.Z)}
+}
+
+// MortonCode returns the 63-bit Z-order curve index of the vector, for spatial indexing such as octrees.
+// Each axis is mapped to (value+offset)*scale, floored, and clamped to 21 bits before the bits are interleaved,
+// in the order X, Y, Z from the least significant bit.
+func (v Vec3[T]) MortonCode(scale, offset T) uint64 {
+	x := mortonQuantize(float64(v.X), float64(scale), float64(offset), 21)
+	y := mortonQuantize(float64(v.Y), float64(scale), float64(offset), 21)
+	z := mortonQuantize(float64(v.Z), float64(scale), float64(offset), 21)
+
+	return spreadBits3(x) | spreadBits3(y)<<1 | spreadBits3(z)<<2
+}
+
+// ToEulerAngles interprets the vector as a direction and returns its Euler angles in radians.
+// The pitch is the elevation from the XZ plane and the yaw is the rotation around the Y axis,
+// following the same convention as Vector3FromEulerAngles.
+// The roll is always zero, since a direction vector has no roll.
+// If the vector points straight up or down, the yaw is zero.
+func (v Vec3[T]) ToEulerAngles() (pitch, yaw, roll T) {
+	pitch = atan2(v.Y, hypot(v.X, v.Z))
+
+	if v.X != 0 || v.Z != 0 {
+		yaw = atan2(-v.X, -v.Z)
+	}
+
+	return pitch, yaw, 0
+}
+
+// ToArray returns the coordinates of the vector as an array.
+func (v Vec3[T]) ToArray() [3]T {
+	return [3]T{v.X, v.Y, v.Z}
+}
+
+// ToSlice returns the coordinates of the vector as a newly allocated slice.
+func (v Vec3[T]) ToSlice() []T {
+	return []T{v.X, v.Y, v.Z}
+}
+
+// ToVector3i converts the vector to an integer vector, truncating each component toward zero.
+func (v Vec3[T]) ToVector3i() Vector3i {
+	return Vector3i{
+		X: int(v.X),
+		Y: int(v.Y),
+		Z: int(v.Z),
+	}
+}
+
+// ToVector2 converts the 3D vector to a 2D vector.
+func (v Vec3[T]) ToVector2() Vec2[T] {
+	return Vec2[T]{
+		X: v.X,
+		Y: v.Y,
+	}
+}
+
+// OrthoNormalize makes three vectors normalized and orthogonal to each other in place,
+// using Gram-Schmidt orthonormalization.
+// u is normalized, v is made orthogonal to u, and w is made orthogonal to both u and v.
+func OrthoNormalize(u, v, w *Vector3) {
+	OrthoNormalize2(u, v)
+
+	w.Reject(*u)
+	w.Reject(*v)
+	w.Normalize()
+}
+
+// OrthoNormalize2 makes two vectors normalized and orthogonal to each other in place,
+// using Gram-Schmidt orthonormalization.
+// u is normalized and v is made orthogonal to u.
+func OrthoNormalize2(u, v *Vector3) {
+	u.Normalize()
+
+	v.Reject(*u)
+	v.Normalize()
+}
+
+// FaceNormal returns the unit normal of the triangle defined by three vertices.
+// The normal follows the right-hand rule for the winding order a, b, c.
+// If the triangle is degenerate, the zero vector is returned.
+func FaceNormal(a, b, c Vector3) Vector3 {
+	normal := FaceNormalUnnormalized(a, b, c)
+	normal.Normalize()
+
+	return normal
+}
+
+// FaceNormalUnnormalized returns the normal of the triangle defined by three vertices,
+// with a magnitude equal to twice the area of the triangle.
+// This is useful for area-weighted averaging of vertex normals.
+func FaceNormalUnnormalized(a, b, c Vector3) Vector3 {
+	return b.Subbed(a).Cross(c.Subbed(a))
+}
+
+// LookAt returns the unit direction vector pointing from one point to another.
+// If both points are equal, the zero vector is returned.
+func LookAt(from, to Vector3) Vector3 {
+	direction := to.Subbed(from)
+	direction.Normalize()
+
+	return direction
+}
+
+// HermiteVector3 interpolates along a cubic Hermite spline from p0 to p1,
+// with t0 and t1 as the tangents at p0 and p1.
+// A t of 0 returns p0 and a t of 1 returns p1.
+func HermiteVector3(p0, p1, t0, t1 Vector3, t float64) Vector3 {
+	t2 := t * t
+	t3 := t2 * t
+
+	result := p0.Scaled(2*t3 - 3*t2 + 1)
+	result.Add(t0.Scaled(t3 - 2*t2 + t))
+	result.Add(p1.Scaled(-2*t3 + 3*t2))
+	result.Add(t1.Scaled(t3 - t2))
+
+	return result
+}
+
+// CatmullRomVector3 interpolates along a uniform Catmull-Rom spline segment between p1 and p2,
+// using p0 and p3 as the neighboring control points.
+// A t of 0 returns p1 and a t of 1 returns p2.
+func CatmullRomVector3(p0, p1, p2, p3 Vector3, t float64) Vector3 {
+	t1 := p2.Subbed(p0)
+	t1.Scale(0.5)
+
+	t2 := p3.Subbed(p1)
+	t2.Scale(0.5)
+
+	return HermiteVector3(p1, p2, t1, t2, t)
+}
+
+// CatmullRomChain3 interpolates along a Catmull-Rom spline passing through all points,
+// where a t of 0 returns the first point and a t of 1 returns the last point.
+// Each segment covers an equal part of t, and t is clamped to [0, 1].
+// The first and last points are repeated to act as their own neighbors.
+// It returns the zero vector for an empty slice.
+func CatmullRomChain3(points []Vector3, t float64) Vector3 {
+	if len(points) == 0 {
+		return Vector3{}
+	}
+
+	if len(points) == 1 {
+		return points[0]
+	}
+
+	segments := len(points) - 1
+	position := math.Max(0, math.Min(1, t)) * float64(segments)
+	segment := min(int(position), segments-1)
+
+	p0 := points[max(segment-1, 0)]
+	p1 := points[segment]
+	p2 := points[segment+1]
+	p3 := points[min(segment+2, segments)]
+
+	return CatmullRomVector3(p0, p1, p2, p3, position-float64(segment))
+}
+
+// SpringVector3 moves a vector towards a target with a critically damped spring, updating the current position and velocity in place.
+// The smoothTime is roughly the time it takes to reach the target, and is limited to a minimum of 0.0001.
+// Call it once per frame with the elapsed deltaTime, keeping the same velocity between calls.
+func SpringVector3(current, target, velocity *Vector3, smoothTime, deltaTime float64) {
+	omega := 2 / math.Max(0.0001, smoothTime)
+	x := omega * deltaTime
+	decay := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := current.Subbed(*target)
+
+	temp := velocity.Added(change.Scaled(omega))
+	temp.Scale(deltaTime)
+
+	velocity.Sub(temp.Scaled(omega))
+	velocity.Scale(decay)
+
+	change.Add(temp)
+	change.Scale(decay)
+	*current = target.Added(change)
+}
+
+// QuadraticBezier3 returns the point at t on the quadratic Bézier curve with control points p0, p1, and p2.
+// A t of 0 returns p0 and a t of 1 returns p2.
+func QuadraticBezier3(p0, p1, p2 Vector3, t float64) Vector3 {
+	u := 1 - t
+
+	result := p0.Scaled(u * u)
+	result.Add(p1.Scaled(2 * u * t))
+	result.Add(p2.Scaled(t * t))
+
+	return result
+}
+
+// QuadraticBezierTangent3 returns the derivative at t of the quadratic Bézier curve with control points p0, p1, and p2.
+// The result is not normalized.
+func QuadraticBezierTangent3(p0, p1, p2 Vector3, t float64) Vector3 {
+	result := p1.Subbed(p0)
+	result.Scale(2 * (1 - t))
+	result.Add(p2.Subbed(p1).Scaled(2 * t))
+
+	return result
+}
+
+// CubicBezier3 returns the point at t on the cubic Bézier curve with control points p0, p1, p2, and p3.
+// A t of 0 returns p0 and a t of 1 returns p3.
+func CubicBezier3(p0, p1, p2, p3 Vector3, t float64) Vector3 {
+	u := 1 - t
+
+	result := p0.Scaled(u * u * u)
+	result.Add(p1.Scaled(3 * u * u * t))
+	result.Add(p2.Scaled(3 * u * t * t))
+	result.Add(p3.Scaled(t * t * t))
+
+	return result
+}
+
+// CubicBezierTangent3 returns the derivative at t of the cubic Bézier curve with control points p0, p1, p2, and p3.
+// The result is not normalized.
+func CubicBezierTangent3(p0, p1, p2, p3 Vector3, t float64) Vector3 {
+	u := 1 - t
+
+	result := p1.Subbed(p0)
+	result.Scale(3 * u * u)
+	result.Add(p2.Subbed(p1).Scaled(6 * u * t))
+	result.Add(p3.Subbed(p2).Scaled(3 * t * t))
+
+	return result
+}
+
+// TripleProduct returns the scalar triple product a · (b × c).
+// This is the signed volume of the parallelepiped spanned by the three vectors,
+// which is positive for a right-handed triple and zero for coplanar vectors.
+func TripleProduct(a, b, c Vector3) float64 {
+	return a.Dot(b.Cross(c))
+}
+
+// VectorTripleProduct returns the vector triple product a × (b × c), computed as b(a · c) - c(a · b).
+func VectorTripleProduct(a, b, c Vector3) Vector3 {
+	return b.Scaled(a.Dot(c)).Subbed(c.Scaled(a.Dot(b)))
+}
+
+// ClosestVector3InSlice returns the candidate closest to a query vector, along with its index and distance.
+// If multiple candidates are equally close, the first one is returned.
+// It returns the zero vector, -1, and 0 for an empty slice.
+func ClosestVector3InSlice(query Vector3, candidates []Vector3) (Vector3, int, float64) {
+	return extremeVector3InSlice(query, candidates, func(a, b float64) bool { return a < b })
+}
+
+// FurthestVector3InSlice returns the candidate furthest from a query vector, along with its index and distance.
+// If multiple candidates are equally far, the first one is returned.
+// It returns the zero vector, -1, and 0 for an empty slice.
+func FurthestVector3InSlice(query Vector3, candidates []Vector3) (Vector3, int, float64) {
+	return extremeVector3InSlice(query, candidates, func(a, b float64) bool { return a > b })
+}
+
+// extremeVector3InSlice returns the first candidate whose squared distance to the query is better than all others.
+func extremeVector3InSlice(query Vector3, candidates []Vector3, better func(a, b float64) bool) (Vector3, int, float64) {
+	if len(candidates) == 0 {
+		return Vector3{}, -1, 0
+	}
+
+	index := 0
+	best := query.DistanceSquared(candidates[0])
+
+	for i, candidate := range candidates[1:] {
+		distanceSquared := query.DistanceSquared(candidate)
+
+		if better(distanceSquared, best) {
+			index = i + 1
+			best = distanceSquared
+		}
+	}
+
+	return candidates[index], index, math.Sqrt(best)
+}
+
+// PointOnSphere returns the point on a sphere at spherical coordinates in radians.
+// This follows the right-handed, Y-up convention, where an azimuth and elevation of zero point along the positive X axis,
+// a positive azimuth rotates counterclockwise around the Y axis, and a positive elevation points up.
+func PointOnSphere(center Vector3, radius, azimuth, elevation float64) Vector3 {
+	sinAzimuth, cosAzimuth := math.Sincos(azimuth)
+	sinElevation, cosElevation := math.Sincos(elevation)
+
+	return Vector3{
+		X: center.X + radius*cosElevation*cosAzimuth,
+		Y: center.Y + radius*sinElevation,
+		Z: center.Z - radius*cosElevation*sinAzimuth,
+	}
+}
+
+// IsCoplanar3D checks if four points lie in a single plane,
+// by checking if the absolute scalar triple product of the edges from a is at most epsilon.
+// The triple product is six times the volume of the tetrahedron the points form.
+// An epsilon of 0 only accepts exactly coplanar points.
+func IsCoplanar3D(a, b, c, d Vector3, epsilon float64) bool {
+	return math.Abs(TripleProduct(b.Subbed(a), c.Subbed(a), d.Subbed(a))) <= epsilon
 }
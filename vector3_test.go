@@ -0,0 +1,2277 @@
+package vectors
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestVector3Cross(t *testing.T) {
+	tests := []struct {
+		name string
+		a    Vector3
+		b    Vector3
+		want Vector3
+	}{
+		{"X cross Y", Vector3{X: 1}, Vector3{Y: 1}, Vector3{Z: 1}},
+		{"Y cross Z", Vector3{Y: 1}, Vector3{Z: 1}, Vector3{X: 1}},
+		{"Z cross X", Vector3{Z: 1}, Vector3{X: 1}, Vector3{Y: 1}},
+		{"Y cross X", Vector3{Y: 1}, Vector3{X: 1}, Vector3{Z: -1}},
+		{"parallel", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 2, Y: 4, Z: 6}, Vector3{}},
+		{"general", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 4, Y: 5, Z: 6}, Vector3{X: -3, Y: 6, Z: -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Cross(tt.b)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("Cross() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3CrossAntiCommutative(t *testing.T) {
+	pairs := [][2]Vector3{
+		{{X: 1, Y: 2, Z: 3}, {X: 4, Y: 5, Z: 6}},
+		{{X: -1.5, Y: 0, Z: 2}, {X: 0.5, Y: -3, Z: 1}},
+		{{X: 1}, {Y: 1}},
+	}
+
+	for _, pair := range pairs {
+		ab := pair[0].Cross(pair[1])
+		ba := pair[1].Cross(pair[0])
+		ba.Bounce()
+
+		if !ab.Equal(ba) {
+			t.Errorf("%v x %v = %v, want the negation of %v x %v", pair[0], pair[1], ab, pair[1], pair[0])
+		}
+	}
+}
+
+func TestVector3Lerp(t *testing.T) {
+	from := Vector3{X: 1, Y: 2, Z: 3}
+	to := Vector3{X: 5, Y: -2, Z: 7}
+
+	tests := []struct {
+		name string
+		t    float64
+		want Vector3
+	}{
+		{"t of 0 leaves the vector unchanged", 0, from},
+		{"t of 1 reaches the target", 1, to},
+		{"t of 0.5 gives the midpoint", 0.5, Vector3{X: 3, Y: 0, Z: 5}},
+		{"t above 1 extrapolates past the target", 2, Vector3{X: 9, Y: -6, Z: 11}},
+		{"t below 0 extrapolates behind the start", -1, Vector3{X: -3, Y: 6, Z: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := from
+			got.Lerp(to, tt.t)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Lerp(%v, %v) = %v, want %v", to, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Reflect(t *testing.T) {
+	diagonal := Vector3{X: 1, Y: 1, Z: 0}.Normalized()
+
+	tests := []struct {
+		name   string
+		v      Vector3
+		normal Vector3
+		want   Vector3
+	}{
+		{"horizontal floor", Vector3{X: 1, Y: -1, Z: 2}, Vector3{Y: 1}, Vector3{X: 1, Y: 1, Z: 2}},
+		{"vertical wall", Vector3{X: 2, Y: 3, Z: -1}, Vector3{X: -1}, Vector3{X: -2, Y: 3, Z: -1}},
+		{"diagonal", Vector3{X: -1, Y: 0, Z: 4}, diagonal, Vector3{X: 0, Y: 1, Z: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.Reflect(tt.normal)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Reflect(%v) = %v, want %v", tt.normal, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Project(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector3
+		onto Vector3
+		want Vector3
+	}{
+		{"onto the X axis", Vector3{X: 3, Y: 4, Z: 5}, Vector3{X: 1}, Vector3{X: 3}},
+		{"onto the Y axis", Vector3{X: 3, Y: 4, Z: 5}, Vector3{Y: 1}, Vector3{Y: 4}},
+		{"onto the Z axis", Vector3{X: 3, Y: 4, Z: 5}, Vector3{Z: 1}, Vector3{Z: 5}},
+		{"onto a general vector", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 2, Y: 2, Z: 2}},
+		{"onto the zero vector", Vector3{X: 3, Y: 4, Z: 5}, Vector3{}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.Project(tt.onto)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Project(%v) = %v, want %v", tt.onto, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Reject(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector3
+		from Vector3
+	}{
+		{"from the Z axis", Vector3{X: 3, Y: 4, Z: 5}, Vector3{Z: 1}},
+		{"from a general vector", Vector3{X: 1, Y: -2, Z: 3.5}, Vector3{X: 0.5, Y: 2, Z: -1}},
+		{"from a parallel vector", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 2, Y: 4, Z: 6}},
+		{"from the zero vector", Vector3{X: 3, Y: 4, Z: 5}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projection := tt.v
+			projection.Project(tt.from)
+
+			rejection := tt.v
+			rejection.Reject(tt.from)
+
+			if sum := projection.Added(rejection); !sum.ApproxEqual(tt.v, testEpsilon) {
+				t.Errorf("Project(%v) + Reject(%v) = %v, want %v", tt.from, tt.from, sum, tt.v)
+			}
+
+			if dot := rejection.Dot(tt.from); !approxEqual(dot, 0, testEpsilon) {
+				t.Errorf("Reject(%v) = %v, which has a dot product of %v with it", tt.from, rejection, dot)
+			}
+		})
+	}
+}
+
+func TestVector3JSON(t *testing.T) {
+	vecs := []Vector3{
+		{X: 1.5, Y: 2.3, Z: 4},
+		{X: -0.1, Y: 1e300, Z: -1e-300},
+		{},
+	}
+
+	for _, v := range vecs {
+		data, err := json.Marshal(v)
+
+		if err != nil {
+			t.Fatalf("json.Marshal(%v) returned an error: %v", v, err)
+		}
+
+		var got Vector3
+
+		err = json.Unmarshal(data, &got)
+
+		if err != nil {
+			t.Fatalf("json.Unmarshal(%s) returned an error: %v", data, err)
+		}
+
+		if !got.Equal(v) {
+			t.Errorf("JSON round trip of %v = %v", v, got)
+		}
+	}
+
+	data, _ := json.Marshal(Vector3{X: 1.5, Y: 2.3, Z: 4})
+
+	if string(data) != `{"x":1.5,"y":2.3,"z":4}` {
+		t.Errorf("json.Marshal() = %s, want %s", data, `{"x":1.5,"y":2.3,"z":4}`)
+	}
+}
+
+func TestVector3UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    Vector3
+		wantErr bool
+	}{
+		{"lowercase keys", `{"x":1.5,"y":2.3,"z":4}`, Vector3{X: 1.5, Y: 2.3, Z: 4}, false},
+		{"uppercase keys", `{"X":1.5,"Y":2.3,"Z":4}`, Vector3{X: 1.5, Y: 2.3, Z: 4}, false},
+		{"array", `[1.5,2.3,4]`, Vector3{}, true},
+		{"bool component", `{"x":true}`, Vector3{}, true},
+		{"not JSON", `x=1`, Vector3{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Vector3
+
+			err := json.Unmarshal([]byte(tt.data), &got)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("json.Unmarshal(%s) returned no error", tt.data)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("json.Unmarshal(%s) returned an error: %v", tt.data, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("json.Unmarshal(%s) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3UnmarshalJSONError(t *testing.T) {
+	var v Vector3
+
+	err := v.UnmarshalJSON([]byte(`[1, 2, 3]`))
+
+	if err == nil || !strings.HasPrefix(err.Error(), "vectors: invalid Vector3 JSON: ") {
+		t.Errorf("UnmarshalJSON() error = %v, want a vectors: invalid Vector3 JSON error", err)
+	}
+}
+
+func FuzzVector3Binary(f *testing.F) {
+	f.Add(0.0, 0.0, 0.0)
+	f.Add(1.5, -2.3, 4.0)
+	f.Add(math.Inf(1), math.Inf(-1), math.NaN())
+	f.Add(math.Copysign(0, -1), math.SmallestNonzeroFloat64, math.MaxFloat64)
+
+	f.Fuzz(func(t *testing.T, x, y, z float64) {
+		v := Vector3{X: x, Y: y, Z: z}
+		data, err := v.MarshalBinary()
+
+		if err != nil {
+			t.Fatalf("MarshalBinary() returned an error: %v", err)
+		}
+
+		if len(data) != 24 {
+			t.Fatalf("MarshalBinary() returned %d bytes, want 24", len(data))
+		}
+
+		var got Vector3
+
+		err = got.UnmarshalBinary(data)
+
+		if err != nil {
+			t.Fatalf("UnmarshalBinary() returned an error: %v", err)
+		}
+
+		if math.Float64bits(got.X) != math.Float64bits(x) ||
+			math.Float64bits(got.Y) != math.Float64bits(y) ||
+			math.Float64bits(got.Z) != math.Float64bits(z) {
+			t.Errorf("binary round trip of %v = %v", v, got)
+		}
+	})
+}
+
+func TestVector3UnmarshalBinaryLength(t *testing.T) {
+	for _, length := range []int{0, 16, 23, 25, 32} {
+		v := Vector3{X: 1, Y: 2, Z: 3}
+
+		err := v.UnmarshalBinary(make([]byte, length))
+
+		if err == nil {
+			t.Errorf("UnmarshalBinary() with %d bytes returned no error", length)
+		}
+
+		if !v.Equal(Vector3{X: 1, Y: 2, Z: 3}) {
+			t.Errorf("UnmarshalBinary() with %d bytes changed the vector to %v", length, v)
+		}
+	}
+}
+
+func TestVector3Abs(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector3
+		want Vector3
+	}{
+		{"all positive", Vector3{X: 1.5, Y: 2, Z: 3}, Vector3{X: 1.5, Y: 2, Z: 3}},
+		{"all negative", Vector3{X: -1.5, Y: -2, Z: -3}, Vector3{X: 1.5, Y: 2, Z: 3}},
+		{"mixed", Vector3{X: -3, Y: 4, Z: -5}, Vector3{X: 3, Y: 4, Z: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Absed(); !got.Equal(tt.want) {
+				t.Errorf("Absed() = %v, want %v", got, tt.want)
+			}
+
+			got := tt.v
+			got.Abs()
+
+			if !got.Equal(tt.want) {
+				t.Errorf("Abs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Rounding(t *testing.T) {
+	v := Vector3{X: 2.5, Y: -2.5, Z: 3}
+
+	tests := []struct {
+		name string
+		f    func(v *Vector3)
+		want Vector3
+	}{
+		{"Floor", (*Vector3).Floor, Vector3{X: 2, Y: -3, Z: 3}},
+		{"Ceil", (*Vector3).Ceil, Vector3{X: 3, Y: -2, Z: 3}},
+		{"Round", (*Vector3).Round, Vector3{X: 3, Y: -3, Z: 3}},
+		{"Trunc", (*Vector3).Trunc, Vector3{X: 2, Y: -2, Z: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v
+			tt.f(&got)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3ComponentMinMax(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       Vector3
+		b       Vector3
+		wantMin Vector3
+		wantMax Vector3
+	}{
+		{
+			"positive",
+			Vector3{X: 1, Y: 5, Z: 2}, Vector3{X: 3, Y: 2, Z: 2},
+			Vector3{X: 1, Y: 2, Z: 2}, Vector3{X: 3, Y: 5, Z: 2},
+		},
+		{
+			"negative",
+			Vector3{X: -1, Y: -5, Z: -7}, Vector3{X: -3, Y: -2, Z: -8},
+			Vector3{X: -3, Y: -5, Z: -8}, Vector3{X: -1, Y: -2, Z: -7},
+		},
+		{
+			"mixed signs",
+			Vector3{X: -1, Y: 5, Z: 0}, Vector3{X: 3, Y: -2, Z: -0.5},
+			Vector3{X: -1, Y: -2, Z: -0.5}, Vector3{X: 3, Y: 5, Z: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin := tt.a
+			gotMin.ComponentMin(tt.b)
+
+			if !gotMin.Equal(tt.wantMin) {
+				t.Errorf("ComponentMin() = %v, want %v", gotMin, tt.wantMin)
+			}
+
+			gotMax := tt.a
+			gotMax.ComponentMax(tt.b)
+
+			if !gotMax.Equal(tt.wantMax) {
+				t.Errorf("ComponentMax() = %v, want %v", gotMax, tt.wantMax)
+			}
+
+			if got := ComponentMinVector3(tt.a, tt.b); !got.Equal(tt.wantMin) {
+				t.Errorf("ComponentMinVector3() = %v, want %v", got, tt.wantMin)
+			}
+
+			if got := ComponentMaxVector3(tt.a, tt.b); !got.Equal(tt.wantMax) {
+				t.Errorf("ComponentMaxVector3() = %v, want %v", got, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestVector3Slerp(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   Vector3
+		target Vector3
+	}{
+		{"right angle", Vector3{X: 1}, Vector3{Y: 1}},
+		{"acute angle", Vector3{X: 1, Y: 0, Z: 0}, Vector3{X: 1, Y: 1, Z: 1}},
+		{"obtuse angle", Vector3{X: 2, Y: 0, Z: 0}, Vector3{X: -1, Y: 0.5, Z: 0}},
+		{"non-unit inputs", Vector3{X: 0, Y: 5, Z: 0}, Vector3{X: 0, Y: 0, Z: 0.25}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.from
+			got.Slerp(tt.target, 0.5)
+
+			if !approxEqual(got.Magnitude(), 1, testEpsilon) {
+				t.Errorf("Slerp(%v, 0.5) = %v, with a magnitude of %v, want 1", tt.target, got, got.Magnitude())
+			}
+
+			fromAngle := got.AngleBetween(tt.from)
+			targetAngle := got.AngleBetween(tt.target)
+
+			if !approxEqual(fromAngle, targetAngle, testEpsilon) {
+				t.Errorf("Slerp(%v, 0.5) = %v, which is %v from the start and %v from the target", tt.target, got, fromAngle, targetAngle)
+			}
+
+			if total := tt.from.AngleBetween(tt.target); !approxEqual(fromAngle+targetAngle, total, testEpsilon) {
+				t.Errorf("Slerp(%v, 0.5) = %v, which is not on the arc between the inputs", tt.target, got)
+			}
+		})
+	}
+}
+
+func TestVector3SlerpEndpoints(t *testing.T) {
+	from := Vector3{X: 1}
+	target := Vector3{Y: 0, Z: 1}
+
+	start := from
+	start.Slerp(target, 0)
+
+	if !start.ApproxEqual(from, testEpsilon) {
+		t.Errorf("Slerp(%v, 0) = %v, want %v", target, start, from)
+	}
+
+	end := from
+	end.Slerp(target, 1)
+
+	if !end.ApproxEqual(target, testEpsilon) {
+		t.Errorf("Slerp(%v, 1) = %v, want %v", target, end, target)
+	}
+}
+
+func TestVector3SlerpDegenerate(t *testing.T) {
+	parallel := Vector3{X: 2}
+	parallel.Slerp(Vector3{X: 5}, 0.5)
+
+	if !parallel.ApproxEqual(Vector3{X: 1}, testEpsilon) {
+		t.Errorf("Slerp() of parallel vectors = %v, want %v", parallel, Vector3{X: 1})
+	}
+
+	antiparallel := Vector3{X: 1}
+	antiparallel.Slerp(Vector3{X: -1}, 0.5)
+
+	if !approxEqual(antiparallel.Magnitude(), 1, testEpsilon) || !approxEqual(antiparallel.X, 0, testEpsilon) {
+		t.Errorf("Slerp() of antiparallel vectors at 0.5 = %v, want a unit vector perpendicular to both", antiparallel)
+	}
+
+	zero := Vector3{X: 1}
+	zero.Slerp(Vector3{}, 0.5)
+
+	if !zero.IsZero() {
+		t.Errorf("Slerp() towards the zero vector = %v, want the zero vector", zero)
+	}
+}
+
+func TestVector3RotateAroundAxis(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       Vector3
+		axis    Vector3
+		radians float64
+		want    Vector3
+	}{
+		{"Y around X", Vector3{Y: 1}, Vector3{X: 1}, math.Pi / 2, Vector3{Z: 1}},
+		{"Z around Y", Vector3{Z: 1}, Vector3{Y: 1}, math.Pi / 2, Vector3{X: 1}},
+		{"X around Z", Vector3{X: 1}, Vector3{Z: 1}, math.Pi / 2, Vector3{Y: 1}},
+		{"X around a non-unit Z axis", Vector3{X: 1}, Vector3{Z: 5}, math.Pi / 2, Vector3{Y: 1}},
+		{"along the axis", Vector3{X: 2}, Vector3{X: 1}, 1, Vector3{X: 2}},
+		{"zero angle", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 1, Z: 0}, 0, Vector3{X: 1, Y: 2, Z: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.v
+			got.RotateAroundAxis(tt.axis, tt.radians)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("RotateAroundAxis(%v, %v) = %v, want %v", tt.axis, tt.radians, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3MoveTowards(t *testing.T) {
+	start := Vector3{X: 1, Y: 1, Z: 1}
+	target := Vector3{X: 1, Y: 4, Z: 5}
+
+	tests := []struct {
+		name     string
+		maxDelta float64
+		want     Vector3
+	}{
+		{"half the distance", 2.5, Vector3{X: 1, Y: 2.5, Z: 3}},
+		{"exactly the distance", 5, target},
+		{"beyond the distance", 100, target},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := start
+			got.MoveTowards(target, tt.maxDelta)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("MoveTowards(%v, %v) = %v, want %v", target, tt.maxDelta, got, tt.want)
+			}
+		})
+	}
+
+	atTarget := target
+	atTarget.MoveTowards(target, 1)
+
+	if !atTarget.Equal(target) {
+		t.Errorf("MoveTowards() from the target = %v, want %v", atTarget, target)
+	}
+}
+
+func TestVector3SmoothStep(t *testing.T) {
+	start := Vector3{X: 1, Y: 1, Z: 1}
+	target := Vector3{X: 3, Y: 5, Z: -1}
+
+	tests := []struct {
+		name         string
+		t            float64
+		wantSmooth   Vector3
+		wantSmoother Vector3
+	}{
+		{"start", 0, start, start},
+		{"end", 1, target, target},
+		{"midpoint", 0.5, Vector3{X: 2, Y: 3, Z: 0}, Vector3{X: 2, Y: 3, Z: 0}},
+		{"clamped below", -0.5, start, start},
+		{"clamped above", 1.5, target, target},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			smooth := start
+			smooth.SmoothStep(target, tt.t)
+
+			if !smooth.ApproxEqual(tt.wantSmooth, testEpsilon) {
+				t.Errorf("SmoothStep(%v, %v) = %v, want %v", target, tt.t, smooth, tt.wantSmooth)
+			}
+
+			smoother := start
+			smoother.SmootherStep(target, tt.t)
+
+			if !smoother.ApproxEqual(tt.wantSmoother, testEpsilon) {
+				t.Errorf("SmootherStep(%v, %v) = %v, want %v", target, tt.t, smoother, tt.wantSmoother)
+			}
+		})
+	}
+}
+
+func TestVector3SafeDiv(t *testing.T) {
+	tests := []struct {
+		name     string
+		divisor  Vector3
+		want     Vector3
+		wantAxis string
+	}{
+		{"non-zero", Vector3{X: 2, Y: -4, Z: 0.5}, Vector3{X: 3, Y: -2, Z: 20}, ""},
+		{"zero X", Vector3{X: 0, Y: 2, Z: 2}, Vector3{X: 6, Y: 8, Z: 10}, "X"},
+		{"zero Y", Vector3{X: 2, Y: 0, Z: 2}, Vector3{X: 6, Y: 8, Z: 10}, "Y"},
+		{"zero Z", Vector3{X: 2, Y: 2, Z: 0}, Vector3{X: 6, Y: 8, Z: 10}, "Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Vector3{X: 6, Y: 8, Z: 10}
+			err := got.SafeDiv(tt.divisor)
+
+			if tt.wantAxis == "" {
+				if err != nil {
+					t.Fatalf("SafeDiv(%v) returned an unexpected error: %v", tt.divisor, err)
+				}
+			} else {
+				if !errors.Is(err, ErrDivisionByZero) {
+					t.Fatalf("SafeDiv(%v) error = %v, want %v", tt.divisor, err, ErrDivisionByZero)
+				}
+
+				if !strings.Contains(err.Error(), tt.wantAxis+" axis") {
+					t.Errorf("SafeDiv(%v) error = %q, want it to name the %s axis", tt.divisor, err, tt.wantAxis)
+				}
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("SafeDiv(%v) = %v, want %v", tt.divisor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3ClampMagnitudeRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Vector3
+		minValue  float64
+		maxValue  float64
+		want      Vector3
+		wantPanic bool
+	}{
+		{"below min", Vector3{X: 0, Y: 0, Z: 0.5}, 2, 6, Vector3{X: 0, Y: 0, Z: 2}, false},
+		{"at min", Vector3{X: 0, Y: -2, Z: 0}, 2, 6, Vector3{X: 0, Y: -2, Z: 0}, false},
+		{"within range", Vector3{X: 1, Y: 2, Z: 2}, 2, 6, Vector3{X: 1, Y: 2, Z: 2}, false},
+		{"at max", Vector3{X: 2, Y: 4, Z: 4}, 2, 6, Vector3{X: 2, Y: 4, Z: 4}, false},
+		{"above max", Vector3{X: 4, Y: 8, Z: 8}, 2, 6, Vector3{X: 2, Y: 4, Z: 4}, false},
+		{"zero vector", Vector3{}, 2, 6, Vector3{}, false},
+		{"min greater than max", Vector3{X: 3}, 5, 2, Vector3{}, true},
+		{"zero vector with min greater than max", Vector3{}, 5, 2, Vector3{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("%v.ClampMagnitudeRange(%v, %v) panicked = %v, want %v", tt.input, tt.minValue, tt.maxValue, r != nil, tt.wantPanic)
+				}
+			}()
+
+			got := tt.input
+			got.ClampMagnitudeRange(tt.minValue, tt.maxValue)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ClampMagnitudeRange(%v, %v) = %v, want %v", tt.input, tt.minValue, tt.maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3FloatChecks(t *testing.T) {
+	nan := math.NaN()
+	inf := math.Inf(1)
+
+	tests := []struct {
+		name       string
+		input      Vector3
+		wantNaN    bool
+		wantInf    bool
+		wantFinite bool
+	}{
+		{"finite", Vector3{X: 1, Y: -2, Z: 3}, false, false, true},
+		{"one NaN", Vector3{X: 0, Y: 0, Z: nan}, true, false, false},
+		{"all NaN", Vector3{X: nan, Y: nan, Z: nan}, true, false, false},
+		{"one infinity", Vector3{X: 0, Y: inf, Z: 0}, false, true, false},
+		{"all infinity", Vector3{X: inf, Y: -inf, Z: inf}, false, true, false},
+		{"NaN and infinity", Vector3{X: nan, Y: 0, Z: -inf}, true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.IsNaN(); got != tt.wantNaN {
+				t.Errorf("%v.IsNaN() = %v, want %v", tt.input, got, tt.wantNaN)
+			}
+
+			if got := tt.input.IsInf(); got != tt.wantInf {
+				t.Errorf("%v.IsInf() = %v, want %v", tt.input, got, tt.wantInf)
+			}
+
+			if got := tt.input.IsFinite(); got != tt.wantFinite {
+				t.Errorf("%v.IsFinite() = %v, want %v", tt.input, got, tt.wantFinite)
+			}
+
+			if tt.input.IsFinite() != (!tt.input.IsNaN() && !tt.input.IsInf()) {
+				t.Errorf("%v.IsFinite() disagrees with IsNaN() and IsInf()", tt.input)
+			}
+		})
+	}
+}
+
+func TestVector3SetMagnitude(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Vector3
+		want  Vector3
+	}{
+		{"shorter", Vector3{X: 0, Y: 0.3, Z: 0.4}, Vector3{X: 0, Y: 3, Z: 4}},
+		{"longer", Vector3{X: 10, Y: 0, Z: 0}, Vector3{X: 5, Y: 0, Z: 0}},
+		{"diagonal", Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 5 / math.Sqrt(3), Y: 5 / math.Sqrt(3), Z: 5 / math.Sqrt(3)}},
+		{"zero vector", Vector3{}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.SetMagnitude(5)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SetMagnitude(5) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			if !tt.input.IsZero() && !approxEqual(got.Magnitude(), 5, testEpsilon) {
+				t.Errorf("%v.SetMagnitude(5) has magnitude %v, want 5", tt.input, got.Magnitude())
+			}
+		})
+	}
+}
+
+func TestVector3IsNormalized(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Vector3
+		want  bool
+	}{
+		{"unit Z", Vector3{X: 0, Y: 0, Z: -1}, true},
+		{"unit diagonal", Vector3{X: 1 / math.Sqrt(3), Y: 1 / math.Sqrt(3), Z: 1 / math.Sqrt(3)}, true},
+		{"small drift", Vector3{X: 0, Y: 1 - 1e-12, Z: 0}, true},
+		{"large drift", Vector3{X: 0, Y: 1 - 1e-6, Z: 0}, false},
+		{"not normalized", Vector3{X: 1, Y: 2, Z: 2}, false},
+		{"zero vector", Vector3{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.IsNormalized(); got != tt.want {
+				t.Errorf("%v.IsNormalized() = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3IsParallelAndPerpendicular(t *testing.T) {
+	tests := []struct {
+		name              string
+		a                 Vector3
+		b                 Vector3
+		wantParallel      bool
+		wantPerpendicular bool
+	}{
+		{"same direction", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 2, Y: 4, Z: 6}, true, false},
+		{"opposite direction", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: -1, Y: -2, Z: -3}, true, false},
+		{"nearly parallel", Vector3{X: 0, Y: 0, Z: 1}, Vector3{X: 1e-12, Y: 0, Z: 1}, true, false},
+		{"perpendicular", Vector3{X: 1, Y: 0, Z: 0}, Vector3{X: 0, Y: 3, Z: -4}, false, true},
+		{"nearly perpendicular", Vector3{X: 1, Y: 0, Z: 0}, Vector3{X: 1e-12, Y: 1, Z: 0}, false, true},
+		{"neither", Vector3{X: 1, Y: 0, Z: 0}, Vector3{X: 1, Y: 1, Z: 0}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsParallel(tt.b, testEpsilon); got != tt.wantParallel {
+				t.Errorf("%v.IsParallel(%v) = %v, want %v", tt.a, tt.b, got, tt.wantParallel)
+			}
+
+			if got := tt.a.IsPerpendicular(tt.b, testEpsilon); got != tt.wantPerpendicular {
+				t.Errorf("%v.IsPerpendicular(%v) = %v, want %v", tt.a, tt.b, got, tt.wantPerpendicular)
+			}
+		})
+	}
+}
+
+func TestVector3SnapToGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Vector3
+		gridSize float64
+		want     Vector3
+	}{
+		{"positive", Vector3{X: 7, Y: 12, Z: 3}, 5, Vector3{X: 5, Y: 10, Z: 5}},
+		{"negative", Vector3{X: -7, Y: -13, Z: -2}, 5, Vector3{X: -5, Y: -15, Z: 0}},
+		{"fractional", Vector3{X: 0.3, Y: 0.74, Z: -0.1}, 0.25, Vector3{X: 0.25, Y: 0.75, Z: 0}},
+		{"grid of one", Vector3{X: 1.4, Y: -1.6, Z: 2.5}, 1, Vector3{X: 1, Y: -2, Z: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.SnapToGrid(tt.gridSize)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SnapToGrid(%v) = %v, want %v", tt.input, tt.gridSize, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3SnapToGridXYZ(t *testing.T) {
+	got := Vector3{X: 7, Y: 7, Z: 7}
+	got.SnapToGridXYZ(5, 2, 0.5)
+
+	if want := (Vector3{X: 5, Y: 8, Z: 7}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("SnapToGridXYZ(5, 2, 0.5) = %v, want %v", got, want)
+	}
+}
+
+func TestVector3SnapToGridZeroPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("SnapToGridXYZ(1, 1, 0) did not panic")
+		}
+	}()
+
+	v := Vector3{X: 1, Y: 1, Z: 1}
+	v.SnapToGridXYZ(1, 1, 0)
+}
+
+func TestVector3SafeNormalize(t *testing.T) {
+	fallback := Vector3{X: 0, Y: 0, Z: 1}
+
+	tests := []struct {
+		name  string
+		input Vector3
+		want  Vector3
+	}{
+		{"non-zero", Vector3{X: 0, Y: 3, Z: 4}, Vector3{X: 0, Y: 0.6, Z: 0.8}},
+		{"already normalized", Vector3{X: 0, Y: -1, Z: 0}, Vector3{X: 0, Y: -1, Z: 0}},
+		{"zero vector", Vector3{}, fallback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.SafeNormalize(fallback)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.SafeNormalize(%v) = %v, want %v", tt.input, fallback, got, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzVector3Text(f *testing.F) {
+	f.Add(0.0, 0.0, 0.0)
+	f.Add(1.5, -2.3, 1e-7)
+	f.Add(math.NaN(), 1.0, math.NaN())
+	f.Add(math.Inf(1), math.Inf(-1), 0.0)
+	f.Add(math.Copysign(0, -1), math.MaxFloat64, math.SmallestNonzeroFloat64)
+
+	f.Fuzz(func(t *testing.T, x, y, z float64) {
+		v := Vector3{X: x, Y: y, Z: z}
+		data, err := v.MarshalText()
+
+		if err != nil {
+			t.Fatalf("MarshalText() returned an error: %v", err)
+		}
+
+		var got Vector3
+
+		err = got.UnmarshalText(data)
+
+		if err != nil {
+			t.Fatalf("UnmarshalText(%q) returned an error: %v", data, err)
+		}
+
+		for _, axis := range [][2]float64{{got.X, x}, {got.Y, y}, {got.Z, z}} {
+			if math.IsNaN(axis[1]) {
+				if !math.IsNaN(axis[0]) {
+					t.Errorf("text round trip of %v through %q = %v", v, data, got)
+				}
+
+				continue
+			}
+
+			if math.Float64bits(axis[0]) != math.Float64bits(axis[1]) {
+				t.Errorf("text round trip of %v through %q = %v", v, data, got)
+			}
+		}
+	})
+}
+
+func TestVector3ArrayConversion(t *testing.T) {
+	v := Vector3{X: 1.5, Y: -2, Z: 3}
+
+	arr := v.ToArray()
+
+	if arr != [3]float64{1.5, -2, 3} {
+		t.Errorf("ToArray() = %v, want [1.5 -2 3]", arr)
+	}
+
+	if got := Vector3FromArray(arr); !got.Equal(v) {
+		t.Errorf("Vector3FromArray(%v) = %v, want %v", arr, got, v)
+	}
+
+	slice := v.ToSlice()
+
+	if len(slice) != 3 || slice[0] != v.X || slice[1] != v.Y || slice[2] != v.Z {
+		t.Errorf("ToSlice() = %v, want [%v %v %v]", slice, v.X, v.Y, v.Z)
+	}
+
+	arr[0] = 100
+	slice[2] = 100
+
+	if want := (Vector3{X: 1.5, Y: -2, Z: 3}); !v.Equal(want) {
+		t.Errorf("modifying the returned array or slice changed the vector to %v", v)
+	}
+}
+
+func TestParseVector3(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Vector3
+	}{
+		{"bare", "1.5,2.3,-4", Vector3{X: 1.5, Y: 2.3, Z: -4}},
+		{"parentheses", "(1.5, 2.3, 0)", Vector3{X: 1.5, Y: 2.3, Z: 0}},
+		{"brackets", "[-1, 0, 1]", Vector3{X: -1, Y: 0, Z: 1}},
+		{"whitespace", "  ( 4 ,  -5e2 , 6 )  ", Vector3{X: 4, Y: -500, Z: 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVector3(tt.input)
+
+			if err != nil {
+				t.Fatalf("ParseVector3(%q) returned an error: %v", tt.input, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseVector3(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVector3Error(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantText string
+	}{
+		{"empty", "", "expected 3 components"},
+		{"too few components", "(1, 2)", "expected 3 components"},
+		{"invalid X", "(a, 2, 3)", "X component"},
+		{"invalid Y", "[1, b, 3]", "Y component"},
+		{"invalid Z", "1, 2, c", "Z component"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseVector3(tt.input)
+
+			if err == nil {
+				t.Fatalf("ParseVector3(%q) returned no error", tt.input)
+			}
+
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Errorf("ParseVector3(%q) error = %q, want it to contain %q", tt.input, err, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestParseVector3StringRoundTrip(t *testing.T) {
+	for _, v := range []Vector3{{X: 0, Y: 0, Z: 0}, {X: 1, Y: -2, Z: 3}, {X: 0.1, Y: 1e21, Z: -1e-7}} {
+		got, err := ParseVector3(v.String())
+
+		if err != nil {
+			t.Fatalf("ParseVector3(%q) returned an error: %v", v.String(), err)
+		}
+
+		if !got.Equal(v) {
+			t.Errorf("ParseVector3(%q) = %v, want %v", v.String(), got, v)
+		}
+	}
+}
+
+func TestSumAndAverageVector3(t *testing.T) {
+	tests := []struct {
+		name        string
+		vecs        []Vector3
+		wantSum     Vector3
+		wantAverage Vector3
+	}{
+		{"empty", []Vector3{}, Vector3{}, Vector3{}},
+		{"single", []Vector3{{X: 3, Y: -1, Z: 2}}, Vector3{X: 3, Y: -1, Z: 2}, Vector3{X: 3, Y: -1, Z: 2}},
+		{"even count", []Vector3{{X: 1, Y: 2, Z: 3}, {X: 3, Y: 4, Z: 5}}, Vector3{X: 4, Y: 6, Z: 8}, Vector3{X: 2, Y: 3, Z: 4}},
+		{"odd count", []Vector3{{X: 3}, {Y: 6}, {Z: 9}}, Vector3{X: 3, Y: 6, Z: 9}, Vector3{X: 1, Y: 2, Z: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SumVector3(tt.vecs); !got.ApproxEqual(tt.wantSum, testEpsilon) {
+				t.Errorf("SumVector3(%v) = %v, want %v", tt.vecs, got, tt.wantSum)
+			}
+
+			if got := AverageVector3(tt.vecs); !got.ApproxEqual(tt.wantAverage, testEpsilon) {
+				t.Errorf("AverageVector3(%v) = %v, want %v", tt.vecs, got, tt.wantAverage)
+			}
+		})
+	}
+}
+
+func TestOrthoNormalize(t *testing.T) {
+	tests := []struct {
+		name    string
+		u, v, w Vector3
+	}{
+		{"axes", Vector3{X: 2}, Vector3{Y: 3}, Vector3{Z: 4}},
+		{"skewed", Vector3{X: 1, Y: 1, Z: 0}, Vector3{X: 1, Y: 0, Z: 1}, Vector3{X: 0, Y: 1, Z: 1}},
+		{"nearly parallel", Vector3{X: 1, Y: 0, Z: 0}, Vector3{X: 1, Y: 1e-3, Z: 0}, Vector3{X: 1, Y: 1e-3, Z: 1e-3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, v, w := tt.u, tt.v, tt.w
+			OrthoNormalize(&u, &v, &w)
+
+			for _, vec := range []Vector3{u, v, w} {
+				if !approxEqual(vec.Magnitude(), 1, testEpsilon) {
+					t.Errorf("OrthoNormalize() produced %v with magnitude %v, want 1", vec, vec.Magnitude())
+				}
+			}
+
+			for _, pair := range [][2]Vector3{{u, v}, {u, w}, {v, w}} {
+				if dot := math.Abs(pair[0].Dot(pair[1])); dot >= 1e-12 {
+					t.Errorf("OrthoNormalize() produced %v and %v with a dot product of %v", pair[0], pair[1], dot)
+				}
+			}
+
+			if want := tt.u.Normalized(); !u.ApproxEqual(want, testEpsilon) {
+				t.Errorf("OrthoNormalize() changed the direction of u to %v, want %v", u, want)
+			}
+		})
+	}
+}
+
+func TestOrthoNormalize2(t *testing.T) {
+	u := Vector3{X: 0, Y: 0, Z: 5}
+	v := Vector3{X: 1, Y: 0, Z: 1}
+	OrthoNormalize2(&u, &v)
+
+	if want := (Vector3{Z: 1}); !u.ApproxEqual(want, testEpsilon) {
+		t.Errorf("OrthoNormalize2() u = %v, want %v", u, want)
+	}
+
+	if want := (Vector3{X: 1}); !v.ApproxEqual(want, testEpsilon) {
+		t.Errorf("OrthoNormalize2() v = %v, want %v", v, want)
+	}
+
+	if dot := math.Abs(u.Dot(v)); dot >= 1e-12 {
+		t.Errorf("OrthoNormalize2() produced a dot product of %v", dot)
+	}
+}
+
+func TestFaceNormal(t *testing.T) {
+	tests := []struct {
+		name             string
+		a, b, c          Vector3
+		want             Vector3
+		wantUnnormalized Vector3
+	}{
+		{"XY plane", Vector3{}, Vector3{X: 2}, Vector3{Y: 2}, Vector3{Z: 1}, Vector3{Z: 4}},
+		{"XY plane reversed", Vector3{}, Vector3{Y: 2}, Vector3{X: 2}, Vector3{Z: -1}, Vector3{Z: -4}},
+		{"YZ plane", Vector3{}, Vector3{Y: 1}, Vector3{Z: 3}, Vector3{X: 1}, Vector3{X: 3}},
+		{"XZ plane offset", Vector3{Y: 5}, Vector3{Y: 5, Z: 1}, Vector3{X: 1, Y: 5}, Vector3{Y: 1}, Vector3{Y: 1}},
+		{"degenerate", Vector3{}, Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 2, Y: 2, Z: 2}, Vector3{}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FaceNormal(tt.a, tt.b, tt.c); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("FaceNormal(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, got, tt.want)
+			}
+
+			if got := FaceNormalUnnormalized(tt.a, tt.b, tt.c); !got.ApproxEqual(tt.wantUnnormalized, testEpsilon) {
+				t.Errorf("FaceNormalUnnormalized(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, got, tt.wantUnnormalized)
+			}
+		})
+	}
+}
+
+func TestVector3FromEulerAngles(t *testing.T) {
+	tests := []struct {
+		name       string
+		pitch, yaw float64
+		want       Vector3
+	}{
+		{"forward", 0, 0, Vector3{Z: -1}},
+		{"left", 0, math.Pi / 2, Vector3{X: -1}},
+		{"right", 0, -math.Pi / 2, Vector3{X: 1}},
+		{"back", 0, math.Pi, Vector3{Z: 1}},
+		{"up", math.Pi / 2, 0, Vector3{Y: 1}},
+		{"down", -math.Pi / 2, 0, Vector3{Y: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Vector3FromEulerAngles(tt.pitch, tt.yaw)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("Vector3FromEulerAngles(%v, %v) = %v, want %v", tt.pitch, tt.yaw, got, tt.want)
+			}
+
+			pitch, yaw, roll := tt.want.ToEulerAngles()
+
+			// A yaw of π and -π describe the same direction, so the yaw is compared modulo 2π.
+			if !approxEqual(pitch, tt.pitch, testEpsilon) || !approxEqual(math.Remainder(yaw-tt.yaw, 2*math.Pi), 0, testEpsilon) || roll != 0 {
+				t.Errorf("%v.ToEulerAngles() = (%v, %v, %v), want (%v, %v, 0)", tt.want, pitch, yaw, roll, tt.pitch, tt.yaw)
+			}
+		})
+	}
+}
+
+func TestVector3EulerAnglesRoundTrip(t *testing.T) {
+	for _, angles := range [][2]float64{{0.3, 0.5}, {-1.2, 2.9}, {1.5, -3}, {-0.01, -0.7}} {
+		v := Vector3FromEulerAngles(angles[0], angles[1])
+		pitch, yaw, _ := v.ToEulerAngles()
+
+		if !approxEqual(pitch, angles[0], testEpsilon) || !approxEqual(yaw, angles[1], testEpsilon) {
+			t.Errorf("Euler angle round trip of (%v, %v) = (%v, %v)", angles[0], angles[1], pitch, yaw)
+		}
+	}
+}
+
+func TestLookAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to Vector3
+		want     Vector3
+	}{
+		{"along Z", Vector3{Z: 1}, Vector3{Z: -4}, Vector3{Z: -1}},
+		{"diagonal", Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 2, Y: 3, Z: 3}, Vector3{X: 1.0 / 3, Y: 2.0 / 3, Z: 2.0 / 3}},
+		{"same point", Vector3{X: 2, Y: 2, Z: 2}, Vector3{X: 2, Y: 2, Z: 2}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LookAt(tt.from, tt.to); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("LookAt(%v, %v) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func testVec3Instantiation[T Float](t *testing.T, wantTypeName string) {
+	v := NewVec3[T](2, 3, 6)
+
+	if got := v.Magnitude(); got != 7 {
+		t.Errorf("%v.Magnitude() = %v, want 7", v, got)
+	}
+
+	normalized := v.Normalized()
+
+	if !normalized.IsNormalized() {
+		t.Errorf("%v.Normalized() = %v, which is not normalized", v, normalized)
+	}
+
+	if got, want := v.Cross(NewVec3[T](1, 0, 0)), NewVec3[T](0, 6, -3); !got.Equal(want) {
+		t.Errorf("%v.Cross() = %v, want %v", v, got, want)
+	}
+
+	if got := v.String(); got != "(2.0, 3.0, 6.0)" {
+		t.Errorf("%v.String() = %q, want %q", v, got, "(2.0, 3.0, 6.0)")
+	}
+
+	if got, want := v.GoString(), "vectors."+wantTypeName+"{"; !strings.HasPrefix(got, want) {
+		t.Errorf("%v.GoString() = %q, want it to start with %q", v, got, want)
+	}
+
+	if got := Vec3FromVector3[T](Vector3FromVec3(v)); !got.Equal(v) {
+		t.Errorf("Vector3 conversion round trip of %v = %v", v, got)
+	}
+
+	data, err := v.MarshalText()
+
+	if err != nil {
+		t.Fatalf("MarshalText() returned an error: %v", err)
+	}
+
+	var decoded Vec3[T]
+
+	if err := decoded.UnmarshalText(data); err != nil || !decoded.Equal(v) {
+		t.Errorf("text round trip of %v = %v, %v", v, decoded, err)
+	}
+
+	err = decoded.UnmarshalText([]byte("1,2"))
+
+	if err == nil || !strings.Contains(err.Error(), wantTypeName) {
+		t.Errorf("UnmarshalText() error = %v, want it to name %s", err, wantTypeName)
+	}
+}
+
+func TestVec3Instantiations(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		testVec3Instantiation[float32](t, "Vector3f32")
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		testVec3Instantiation[float64](t, "Vector3")
+	})
+}
+
+func TestVector3DistanceMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          Vector3
+		wantManhattan float64
+		wantChebyshev float64
+	}{
+		{"same point", Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 1, Y: 1, Z: 1}, 0, 0},
+		{"axis aligned", Vector3{}, Vector3{Z: -4}, 4, 4},
+		{"diagonal", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 4, Y: -2, Z: 8}, 12, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.ManhattanDistance(tt.b); got != tt.wantManhattan {
+				t.Errorf("%v.ManhattanDistance(%v) = %v, want %v", tt.a, tt.b, got, tt.wantManhattan)
+			}
+
+			if got := tt.a.ChebyshevDistance(tt.b); got != tt.wantChebyshev {
+				t.Errorf("%v.ChebyshevDistance(%v) = %v, want %v", tt.a, tt.b, got, tt.wantChebyshev)
+			}
+
+			if got, want := tt.a.MinkowskiDistance(tt.b, 2), tt.a.Distance(tt.b); !approxEqual(got, want, testEpsilon) {
+				t.Errorf("%v.MinkowskiDistance(%v, 2) = %v, want %v", tt.a, tt.b, got, want)
+			}
+		})
+	}
+}
+
+func TestVector3CosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vector3
+		want float64
+	}{
+		{"same direction", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 2, Y: 4, Z: 6}, 1},
+		{"opposite direction", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: -1, Y: -2, Z: -3}, -1},
+		{"perpendicular", Vector3{X: 1}, Vector3{Z: 5}, 0},
+		{"60 degrees", Vector3{X: 1, Y: 1}, Vector3{Y: 1, Z: 1}, 0.5},
+		{"zero vector", Vector3{X: 1}, Vector3{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.CosineSimilarity(tt.b); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("%v.CosineSimilarity(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3LerpClampedAndUnclamped(t *testing.T) {
+	start := Vector3{X: 1, Y: 1, Z: 1}
+	target := Vector3{X: 3, Y: 1, Z: -1}
+
+	tests := []struct {
+		name          string
+		t             float64
+		wantUnclamped Vector3
+		wantClamped   Vector3
+	}{
+		{"midpoint", 0.5, Vector3{X: 2, Y: 1, Z: 0}, Vector3{X: 2, Y: 1, Z: 0}},
+		{"before start", -1, Vector3{X: -1, Y: 1, Z: 3}, start},
+		{"past end", 2, Vector3{X: 5, Y: 1, Z: -3}, target},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unclamped := start
+			unclamped.LerpUnclamped(target, tt.t)
+
+			if !unclamped.ApproxEqual(tt.wantUnclamped, testEpsilon) {
+				t.Errorf("LerpUnclamped(%v, %v) = %v, want %v", target, tt.t, unclamped, tt.wantUnclamped)
+			}
+
+			clamped := start
+			clamped.LerpClamped(target, tt.t)
+
+			if !clamped.ApproxEqual(tt.wantClamped, testEpsilon) {
+				t.Errorf("LerpClamped(%v, %v) = %v, want %v", target, tt.t, clamped, tt.wantClamped)
+			}
+		})
+	}
+}
+
+func TestHermiteVector3(t *testing.T) {
+	p0 := Vector3{X: 1, Y: 0, Z: -1}
+	p1 := Vector3{X: 4, Y: 2, Z: 0}
+	t0 := Vector3{X: 1, Y: 3, Z: 0}
+	t1 := Vector3{X: -2, Y: 1, Z: 5}
+
+	if got := HermiteVector3(p0, p1, t0, t1, 0); !got.ApproxEqual(p0, testEpsilon) {
+		t.Errorf("HermiteVector3() at t=0 = %v, want %v", got, p0)
+	}
+
+	if got := HermiteVector3(p0, p1, t0, t1, 1); !got.ApproxEqual(p1, testEpsilon) {
+		t.Errorf("HermiteVector3() at t=1 = %v, want %v", got, p1)
+	}
+
+	const h = 1e-6
+
+	start := HermiteVector3(p0, p1, t0, t1, h).Subbed(HermiteVector3(p0, p1, t0, t1, 0)).Scaled(1 / h)
+
+	if !start.ApproxEqual(t0, 1e-4) {
+		t.Errorf("HermiteVector3() derivative at t=0 = %v, want %v", start, t0)
+	}
+
+	end := HermiteVector3(p0, p1, t0, t1, 1).Subbed(HermiteVector3(p0, p1, t0, t1, 1-h)).Scaled(1 / h)
+
+	if !end.ApproxEqual(t1, 1e-4) {
+		t.Errorf("HermiteVector3() derivative at t=1 = %v, want %v", end, t1)
+	}
+}
+
+func TestCatmullRomVector3(t *testing.T) {
+	p0 := Vector3{X: -1, Y: 1, Z: 0}
+	p1 := Vector3{X: 0, Y: 0, Z: 1}
+	p2 := Vector3{X: 2, Y: 1, Z: 2}
+	p3 := Vector3{X: 3, Y: 3, Z: 0}
+
+	if got := CatmullRomVector3(p0, p1, p2, p3, 0); !got.ApproxEqual(p1, testEpsilon) {
+		t.Errorf("CatmullRomVector3() at t=0 = %v, want %v", got, p1)
+	}
+
+	if got := CatmullRomVector3(p0, p1, p2, p3, 1); !got.ApproxEqual(p2, testEpsilon) {
+		t.Errorf("CatmullRomVector3() at t=1 = %v, want %v", got, p2)
+	}
+}
+
+func TestCatmullRomChain3(t *testing.T) {
+	points := []Vector3{{X: 0, Y: 0, Z: 0}, {X: 1, Y: 2, Z: -1}, {X: 3, Y: 1, Z: 2}}
+
+	for i, point := range points {
+		at := float64(i) / float64(len(points)-1)
+
+		if got := CatmullRomChain3(points, at); !got.ApproxEqual(point, testEpsilon) {
+			t.Errorf("CatmullRomChain3() at t=%v = %v, want %v", at, got, point)
+		}
+	}
+
+	const h = 1e-7
+
+	before := CatmullRomChain3(points, 0.5).Subbed(CatmullRomChain3(points, 0.5-h)).Scaled(1 / h)
+	after := CatmullRomChain3(points, 0.5+h).Subbed(CatmullRomChain3(points, 0.5)).Scaled(1 / h)
+
+	if !before.ApproxEqual(after, 1e-4) {
+		t.Errorf("CatmullRomChain3() derivative at the middle point is %v before and %v after", before, after)
+	}
+}
+
+func TestVector3Wrap(t *testing.T) {
+	minValue := Vector3{X: 0, Y: -5, Z: 1}
+	maxValue := Vector3{X: 10, Y: 5, Z: 2}
+
+	tests := []struct {
+		name  string
+		input Vector3
+		want  Vector3
+	}{
+		{"inside", Vector3{X: 3, Y: 2, Z: 1.5}, Vector3{X: 3, Y: 2, Z: 1.5}},
+		{"at max", Vector3{X: 10, Y: 5, Z: 2}, Vector3{X: 0, Y: -5, Z: 1}},
+		{"outside", Vector3{X: -3, Y: 7, Z: 3.25}, Vector3{X: 7, Y: -3, Z: 1.25}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input
+			got.Wrap(minValue, maxValue)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.Wrap(%v, %v) = %v, want %v", tt.input, minValue, maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3ToroidalDistance(t *testing.T) {
+	size := Vector3{X: 10, Y: 10, Z: 10}
+	a := Vector3{X: 9, Y: 0, Z: 1}
+	b := Vector3{X: 1, Y: 0, Z: 9}
+
+	if got, want := a.ToroidalDistance(b, size), math.Sqrt(8); !approxEqual(got, want, testEpsilon) {
+		t.Errorf("%v.ToroidalDistance(%v, %v) = %v, want %v", a, b, size, got, want)
+	}
+}
+
+func TestVector3ClosestPointOnSegment(t *testing.T) {
+	tests := []struct {
+		name         string
+		point        Vector3
+		a, b         Vector3
+		want         Vector3
+		wantDistance float64
+	}{
+		{"beside the middle", Vector3{X: 3, Y: 4, Z: 2}, Vector3{}, Vector3{Z: 4}, Vector3{Z: 2}, 5},
+		{"before a", Vector3{X: 0, Y: 3, Z: -4}, Vector3{}, Vector3{Z: 4}, Vector3{}, 5},
+		{"past b", Vector3{X: 0, Y: 0, Z: 6}, Vector3{}, Vector3{Z: 4}, Vector3{Z: 4}, 2},
+		{"degenerate segment", Vector3{X: 3, Y: 4, Z: 1}, Vector3{Z: 1}, Vector3{Z: 1}, Vector3{Z: 1}, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.ClosestPointOnSegment(tt.a, tt.b); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ClosestPointOnSegment(%v, %v) = %v, want %v", tt.point, tt.a, tt.b, got, tt.want)
+			}
+
+			if got := tt.point.DistanceToSegment(tt.a, tt.b); !approxEqual(got, tt.wantDistance, testEpsilon) {
+				t.Errorf("%v.DistanceToSegment(%v, %v) = %v, want %v", tt.point, tt.a, tt.b, got, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestVector3MapAndZip(t *testing.T) {
+	v := Vector3{X: -1.5, Y: 4, Z: -0.25}
+
+	if got, want := v.Map(math.Abs), (Vector3{X: 1.5, Y: 4, Z: 0.25}); !got.Equal(want) {
+		t.Errorf("%v.Map(math.Abs) = %v, want %v", v, got, want)
+	}
+
+	other := Vector3{X: 2, Y: -3, Z: 1}
+	sub := func(a, b float64) float64 { return a - b }
+
+	if got, want := ZipVector3(v, other, sub), v.Subbed(other); !got.Equal(want) {
+		t.Errorf("ZipVector3(%v, %v, sub) = %v, want %v", v, other, got, want)
+	}
+}
+
+func TestVector3SaturateAndFract(t *testing.T) {
+	v := Vector3{X: -0.5, Y: 0.5, Z: 2.25}
+
+	if got, want := v.Saturated(), (Vector3{X: 0, Y: 0.5, Z: 1}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("%v.Saturated() = %v, want %v", v, got, want)
+	}
+
+	if got, want := v.Fracted(), (Vector3{X: 0.5, Y: 0.5, Z: 0.25}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("%v.Fracted() = %v, want %v", v, got, want)
+	}
+}
+
+func TestVector3StepComponent(t *testing.T) {
+	v := Vector3{X: 0.25, Y: 0.5, Z: 1}
+	edge := Vector3{X: 0.5, Y: 0.5, Z: 0.5}
+
+	if got, want := v.Step(edge), (Vector3{X: 0, Y: 1, Z: 1}); !got.Equal(want) {
+		t.Errorf("%v.Step(%v) = %v, want %v", v, edge, got, want)
+	}
+
+	edge0 := Vector3{}
+	edge1 := Vector3{X: 1, Y: 1, Z: 1}
+
+	if got, want := v.SmoothStepComponent(edge0, edge1), (Vector3{X: 0.15625, Y: 0.5, Z: 1}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("%v.SmoothStepComponent(%v, %v) = %v, want %v", v, edge0, edge1, got, want)
+	}
+}
+
+func TestVector3Quantize(t *testing.T) {
+	got := Vector3{X: 1.23456, Y: -9.87654, Z: 0.005}
+	got.Quantize(2)
+
+	if want := (Vector3{X: 1.23, Y: -9.88, Z: 0.01}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("Quantize(2) = %v, want %v", got, want)
+	}
+
+	stepped := Vector3{X: 0.3, Y: -0.9, Z: 2.1}
+	stepped.QuantizeToStep(0.5)
+
+	if want := (Vector3{X: 0.5, Y: -1, Z: 2}); !stepped.ApproxEqual(want, testEpsilon) {
+		t.Errorf("QuantizeToStep(0.5) = %v, want %v", stepped, want)
+	}
+}
+
+func TestSpringVector3(t *testing.T) {
+	current := Vector3{X: 0, Y: 0, Z: 0}
+	target := Vector3{X: 1, Y: 2, Z: 3}
+	velocity := Vector3{}
+
+	for range 300 {
+		SpringVector3(&current, &target, &velocity, 0.25, 1.0/60)
+	}
+
+	if !current.ApproxEqual(target, 1e-3) {
+		t.Errorf("SpringVector3() after 5 seconds = %v, want %v", current, target)
+	}
+
+	if velocity.Magnitude() > 1e-2 {
+		t.Errorf("SpringVector3() after 5 seconds has velocity %v, want roughly zero", velocity)
+	}
+}
+
+func TestBezier3(t *testing.T) {
+	p0 := Vector3{X: 0, Y: 0, Z: 0}
+	p1 := Vector3{X: 1, Y: 2, Z: 0}
+	p2 := Vector3{X: 3, Y: 2, Z: 2}
+	p3 := Vector3{X: 4, Y: 0, Z: 2}
+
+	tests := []struct {
+		name string
+		got  Vector3
+		want Vector3
+	}{
+		{"quadratic start", QuadraticBezier3(p0, p1, p2, 0), p0},
+		{"quadratic end", QuadraticBezier3(p0, p1, p2, 1), p2},
+		{"quadratic end tangent", QuadraticBezierTangent3(p0, p1, p2, 1), Vector3{X: 4, Y: 0, Z: 4}},
+		{"cubic start", CubicBezier3(p0, p1, p2, p3, 0), p0},
+		{"cubic end", CubicBezier3(p0, p1, p2, p3, 1), p3},
+		{"cubic midpoint", CubicBezier3(p0, p1, p2, p3, 0.5), Vector3{X: 2, Y: 1.5, Z: 1}},
+		{"cubic start tangent", CubicBezierTangent3(p0, p1, p2, p3, 0), Vector3{X: 3, Y: 6, Z: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3ReflectWithRestitution(t *testing.T) {
+	normal := Vector3{X: 0, Y: 0, Z: 1}
+
+	tests := []struct {
+		name        string
+		restitution float64
+		want        Vector3
+	}{
+		{"perfect bounce", 1, Vector3{X: 1, Y: 2, Z: 4}},
+		{"quarter bounce", 0.25, Vector3{X: 1, Y: 2, Z: 1}},
+		{"slide", 0, Vector3{X: 1, Y: 2, Z: 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Vector3{X: 1, Y: 2, Z: -4}
+			got.ReflectWithRestitution(normal, tt.restitution)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("ReflectWithRestitution(%v, %v) = %v, want %v", normal, tt.restitution, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTripleProduct(t *testing.T) {
+	x := Vector3{X: 1}
+	y := Vector3{Y: 1}
+	z := Vector3{Z: 1}
+
+	tests := []struct {
+		name    string
+		a, b, c Vector3
+		want    float64
+	}{
+		{"right-handed", x, y, z, 1},
+		{"left-handed", y, x, z, -1},
+		{"cyclic", z, x, y, 1},
+		{"scaled box", Vector3{X: 2}, Vector3{Y: 3}, Vector3{Z: 4}, 24},
+		{"coplanar", x, y, Vector3{X: 1, Y: 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TripleProduct(tt.a, tt.b, tt.c); !approxEqual(got, tt.want, testEpsilon) {
+				t.Errorf("TripleProduct(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVectorTripleProduct(t *testing.T) {
+	a := Vector3{X: 1, Y: 2, Z: 3}
+	b := Vector3{X: -1, Y: 0, Z: 2}
+	c := Vector3{X: 4, Y: 1, Z: -1}
+
+	if got, want := VectorTripleProduct(a, b, c), a.Cross(b.Cross(c)); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("VectorTripleProduct(%v, %v, %v) = %v, want %v", a, b, c, got, want)
+	}
+}
+
+func TestVector3InSlice(t *testing.T) {
+	candidates := []Vector3{{X: 5}, {X: 1, Y: 1, Z: 1}, {Z: -10}}
+	query := Vector3{X: 1, Y: 1}
+
+	if closest, index, distance := ClosestVector3InSlice(query, candidates); index != 1 || !closest.Equal(candidates[1]) || !approxEqual(distance, 1, testEpsilon) {
+		t.Errorf("ClosestVector3InSlice(%v) = (%v, %d, %v), want (%v, 1, 1)", query, closest, index, distance, candidates[1])
+	}
+
+	if furthest, index, _ := FurthestVector3InSlice(query, candidates); index != 2 || !furthest.Equal(candidates[2]) {
+		t.Errorf("FurthestVector3InSlice(%v) = (%v, %d), want (%v, 2)", query, furthest, index, candidates[2])
+	}
+
+	if _, index, _ := ClosestVector3InSlice(query, []Vector3{}); index != -1 {
+		t.Errorf("ClosestVector3InSlice() of an empty slice returned index %d, want -1", index)
+	}
+}
+
+func TestVector3Hash(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+
+	if a, b := (Vector3{X: 1, Y: negativeZero, Z: 3}), (Vector3{X: 1, Y: 0, Z: 3}); a.Hash() != b.Hash() {
+		t.Errorf("%v.Hash() != %v.Hash()", a, b)
+	}
+
+	if a, b := (Vector3{X: 1, Y: 2, Z: 3}), (Vector3{X: 3, Y: 2, Z: 1}); a.Hash() == b.Hash() {
+		t.Errorf("%v.Hash() == %v.Hash()", a, b)
+	}
+
+	if a, b := (Vector3{X: 1.01, Y: 2, Z: -2.99}), (Vector3{X: 0.99, Y: 2.01, Z: -3}); a.QuantizedHash(0.1) != b.QuantizedHash(0.1) {
+		t.Errorf("%v.QuantizedHash(0.1) != %v.QuantizedHash(0.1)", a, b)
+	}
+}
+
+func TestVector3DirectionToAndFrom(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vector3
+		want Vector3
+	}{
+		{"along Z", Vector3{Z: 1}, Vector3{Z: -1}, Vector3{Z: -1}},
+		{"diagonal", Vector3{}, Vector3{X: 2, Y: -1, Z: 2}, Vector3{X: 2.0 / 3, Y: -1.0 / 3, Z: 2.0 / 3}},
+		{"same point", Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 1, Y: 1, Z: 1}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.DirectionTo(tt.b); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.DirectionTo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if got := tt.b.DirectionFrom(tt.a); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.DirectionFrom(%v) = %v, want %v", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPointOnSphere(t *testing.T) {
+	center := Vector3{X: 1, Y: 2, Z: 3}
+
+	tests := []struct {
+		name      string
+		azimuth   float64
+		elevation float64
+		want      Vector3
+	}{
+		{"positive X", 0, 0, Vector3{X: 3, Y: 2, Z: 3}},
+		{"quarter turn", math.Pi / 2, 0, Vector3{X: 1, Y: 2, Z: 1}},
+		{"half turn", math.Pi, 0, Vector3{X: -1, Y: 2, Z: 3}},
+		{"up", 0, math.Pi / 2, Vector3{X: 1, Y: 4, Z: 3}},
+		{"down", 1, -math.Pi / 2, Vector3{X: 1, Y: 0, Z: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PointOnSphere(center, 2, tt.azimuth, tt.elevation)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("PointOnSphere(%v, 2, %v, %v) = %v, want %v", center, tt.azimuth, tt.elevation, got, tt.want)
+			}
+
+			if !approxEqual(got.Distance(center), 2, testEpsilon) {
+				t.Errorf("PointOnSphere(%v, 2, %v, %v) is %v away from the center, want 2", center, tt.azimuth, tt.elevation, got.Distance(center))
+			}
+		})
+	}
+}
+
+func TestVector3Swizzles(t *testing.T) {
+	v := Vector3{X: 1, Y: 2, Z: 3}
+
+	tests := []struct {
+		name string
+		got  Vector2
+		want Vector2
+	}{
+		{"XY", v.XY(), Vector2{X: 1, Y: 2}},
+		{"XZ", v.XZ(), Vector2{X: 1, Y: 3}},
+		{"YZ", v.YZ(), Vector2{X: 2, Y: 3}},
+		{"YX", v.YX(), Vector2{X: 2, Y: 1}},
+		{"ZX", v.ZX(), Vector2{X: 3, Y: 1}},
+		{"ZY", v.ZY(), Vector2{X: 3, Y: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("%v.%s() = %v, want %v", v, tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3ProjectOntoPlane(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  Vector3
+		normal Vector3
+		want   Vector3
+	}{
+		{"XY plane", Vector3{X: 1, Y: 2, Z: 3}, Vector3{Z: 1}, Vector3{X: 1, Y: 2}},
+		{"flipped normal", Vector3{X: 1, Y: 2, Z: 3}, Vector3{Z: -1}, Vector3{X: 1, Y: 2}},
+		{"already in the plane", Vector3{X: 4, Z: -1}, Vector3{Y: 1}, Vector3{X: 4, Z: -1}},
+		{"parallel to the normal", Vector3{X: 2, Y: 2}, Vector3{X: math.Sqrt2 / 2, Y: math.Sqrt2 / 2}, Vector3{}},
+		{"diagonal plane", Vector3{X: 1}, Vector3{X: math.Sqrt2 / 2, Y: math.Sqrt2 / 2}, Vector3{X: 0.5, Y: -0.5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.ProjectedOntoPlane(tt.normal)
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.ProjectedOntoPlane(%v) = %v, want %v", tt.input, tt.normal, got, tt.want)
+			}
+
+			if !approxEqual(got.Dot(tt.normal), 0, testEpsilon) {
+				t.Errorf("%v.ProjectedOntoPlane(%v) is not in the plane", tt.input, tt.normal)
+			}
+
+			inPlace := tt.input
+			inPlace.ProjectOntoPlane(tt.normal)
+
+			if !inPlace.Equal(got) {
+				t.Errorf("%v.ProjectOntoPlane(%v) = %v, want %v", tt.input, tt.normal, inPlace, got)
+			}
+		})
+	}
+}
+
+func TestVector3ScaleAround(t *testing.T) {
+	pivot := Vector3{X: 1, Y: 1, Z: 1}
+
+	got := Vector3{X: 2, Y: 3, Z: 0}
+	got.ScaleAround(pivot, 3)
+
+	if want := (Vector3{X: 4, Y: 7, Z: -2}); !got.ApproxEqual(want, testEpsilon) {
+		t.Errorf("ScaleAround(%v, 3) = %v, want %v", pivot, got, want)
+	}
+
+	nonUniform := Vector3{X: 3, Y: 3, Z: 3}
+	nonUniform.ScaleAroundNonUniform(pivot, Vector3{X: 2, Y: 0, Z: -1})
+
+	if want := (Vector3{X: 5, Y: 1, Z: -1}); !nonUniform.ApproxEqual(want, testEpsilon) {
+		t.Errorf("ScaleAroundNonUniform() = %v, want %v", nonUniform, want)
+	}
+}
+
+func TestIsCoplanar3D(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b, c, d Vector3
+		epsilon    float64
+		want       bool
+	}{
+		{"XY plane", Vector3{}, Vector3{X: 1}, Vector3{Y: 1}, Vector3{X: 5, Y: -3}, testEpsilon, true},
+		{"exact with zero epsilon", Vector3{}, Vector3{X: 1}, Vector3{Y: 1}, Vector3{X: 5, Y: -3}, 0, true},
+		{"nearly coplanar with zero epsilon", Vector3{}, Vector3{X: 1}, Vector3{Y: 1}, Vector3{X: 5, Y: -3, Z: 1e-12}, 0, false},
+		{"tilted plane", Vector3{Z: 1}, Vector3{X: 1, Z: 2}, Vector3{Y: 1, Z: 1}, Vector3{X: 2, Y: 2, Z: 3}, testEpsilon, true},
+		{"unit tetrahedron", Vector3{}, Vector3{X: 1}, Vector3{Y: 1}, Vector3{Z: 1}, testEpsilon, false},
+		{"below the volume threshold", Vector3{}, Vector3{X: 1}, Vector3{Y: 1}, Vector3{Z: 0.5}, 0.6, true},
+		{"above the volume threshold", Vector3{}, Vector3{X: 1}, Vector3{Y: 1}, Vector3{Z: 0.5}, 0.4, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCoplanar3D(tt.a, tt.b, tt.c, tt.d, tt.epsilon); got != tt.want {
+				t.Errorf("IsCoplanar3D(%v, %v, %v, %v, %v) = %v, want %v", tt.a, tt.b, tt.c, tt.d, tt.epsilon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Decompose(t *testing.T) {
+	v := Vector3{X: 1, Y: 2, Z: 3}
+	axis := Vector3{X: 0, Y: 0, Z: -2}
+
+	parallel, perpendicular := v.Decompose(axis)
+
+	if want := (Vector3{Z: 3}); !parallel.ApproxEqual(want, testEpsilon) {
+		t.Errorf("%v.Decompose(%v) parallel = %v, want %v", v, axis, parallel, want)
+	}
+
+	if want := (Vector3{X: 1, Y: 2}); !perpendicular.ApproxEqual(want, testEpsilon) {
+		t.Errorf("%v.Decompose(%v) perpendicular = %v, want %v", v, axis, perpendicular, want)
+	}
+
+	parallel, perpendicular = v.Decompose(Vector3{})
+
+	if !parallel.IsZero() || !perpendicular.Equal(v) {
+		t.Errorf("%v.Decompose() with a zero axis = (%v, %v), want (0, %v)", v, parallel, perpendicular, v)
+	}
+}
+
+func TestVector3ToAxisAngle(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Vector3
+		wantAxis  Vector3
+		wantAngle float64
+	}{
+		{"quarter turn around Y", Vector3{Y: math.Pi / 2}, Vector3{Y: 1}, math.Pi / 2},
+		{"half turn around negative Z", Vector3{Z: -math.Pi}, Vector3{Z: -1}, math.Pi},
+		{"diagonal", Vector3{X: 1, Y: 2, Z: 2}, Vector3{X: 1.0 / 3, Y: 2.0 / 3, Z: 2.0 / 3}, 3},
+		{"no rotation", Vector3{}, Vector3{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			axis, angle := tt.input.ToAxisAngle()
+
+			if !axis.ApproxEqual(tt.wantAxis, testEpsilon) || !approxEqual(angle, tt.wantAngle, testEpsilon) {
+				t.Errorf("%v.ToAxisAngle() = (%v, %v), want (%v, %v)", tt.input, axis, angle, tt.wantAxis, tt.wantAngle)
+			}
+
+			if rebuilt := axis.Scaled(angle); !rebuilt.ApproxEqual(tt.input, testEpsilon) {
+				t.Errorf("%v.ToAxisAngle() does not scale back to the input, got %v", tt.input, rebuilt)
+			}
+		})
+	}
+
+	point := Vector3{X: 1}
+	axis, angle := (Vector3{Z: math.Pi / 2}).ToAxisAngle()
+	point.RotateAroundAxis(axis, angle)
+
+	if want := (Vector3{Y: 1}); !point.ApproxEqual(want, testEpsilon) {
+		t.Errorf("rotating (1, 0, 0) by the axis-angle of a quarter turn around Z = %v, want %v", point, want)
+	}
+}
+
+func TestVector3IsInSphere(t *testing.T) {
+	center := Vector3{X: 1, Y: 1, Z: 1}
+
+	tests := []struct {
+		name         string
+		point        Vector3
+		wantInside   bool
+		wantOnSphere bool
+	}{
+		{"center", center, true, false},
+		{"inside", Vector3{X: 2, Y: 2, Z: 2}, true, false},
+		{"on the boundary", Vector3{X: 1, Y: 1, Z: -1}, true, true},
+		{"just outside the boundary", Vector3{X: 1, Y: 3 + 1e-12, Z: 1}, false, true},
+		{"outside", Vector3{X: 3, Y: 3, Z: 3}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.IsInSphere(center, 2); got != tt.wantInside {
+				t.Errorf("%v.IsInSphere(%v, 2) = %v, want %v", tt.point, center, got, tt.wantInside)
+			}
+
+			if got := tt.point.IsOnSphere(center, 2, testEpsilon); got != tt.wantOnSphere {
+				t.Errorf("%v.IsOnSphere(%v, 2) = %v, want %v", tt.point, center, got, tt.wantOnSphere)
+			}
+		})
+	}
+}
+
+func TestVector3IsInAABB(t *testing.T) {
+	minValue := Vector3{X: -1, Y: -1, Z: -1}
+	maxValue := Vector3{X: 1, Y: 1, Z: 1}
+
+	tests := []struct {
+		name          string
+		point         Vector3
+		wantInclusive bool
+		wantExclusive bool
+	}{
+		{"inside", Vector3{}, true, true},
+		{"outside on X", Vector3{X: 2}, false, false},
+		{"outside on Y", Vector3{Y: -2}, false, false},
+		{"outside on Z", Vector3{Z: 2}, false, false},
+		{"on a face", Vector3{Z: 1}, true, false},
+		{"on a corner", Vector3{X: -1, Y: 1, Z: -1}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.IsInAABB(minValue, maxValue); got != tt.wantInclusive {
+				t.Errorf("%v.IsInAABB(%v, %v) = %v, want %v", tt.point, minValue, maxValue, got, tt.wantInclusive)
+			}
+
+			if got := tt.point.IsInAABBExclusive(minValue, maxValue); got != tt.wantExclusive {
+				t.Errorf("%v.IsInAABBExclusive(%v, %v) = %v, want %v", tt.point, minValue, maxValue, got, tt.wantExclusive)
+			}
+		})
+	}
+
+	if (Vector3{}).IsInAABB(maxValue, minValue) {
+		t.Errorf("the origin is inside the inverted box from %v to %v", maxValue, minValue)
+	}
+}
+
+func TestVector3OrthogonalVector(t *testing.T) {
+	vecs := []Vector3{
+		{X: 1},
+		{Y: -1},
+		{Z: 1},
+		{X: 1, Y: 1, Z: 1},
+		{X: 1e-8, Y: 0, Z: 1},
+		{X: -3, Y: 2, Z: 0.5},
+	}
+
+	rng := rand.New(rand.NewPCG(7, 8))
+
+	for range 1000 {
+		vecs = append(vecs, RandomUnitVector3(rng))
+	}
+
+	for _, v := range vecs {
+		orthogonal := v.OrthogonalVector()
+
+		if orthogonal.IsNaN() {
+			t.Fatalf("%v.OrthogonalVector() = %v", v, orthogonal)
+		}
+
+		if !orthogonal.IsNormalized() {
+			t.Errorf("%v.OrthogonalVector() = %v, which is not normalized", v, orthogonal)
+		}
+
+		if dot := orthogonal.Dot(v) / v.Magnitude(); !approxEqual(dot, 0, testEpsilon) {
+			t.Errorf("%v.OrthogonalVector() = %v, which has a dot product of %v", v, orthogonal, dot)
+		}
+	}
+
+	if got := (Vector3{}).OrthogonalVector(); !got.IsZero() {
+		t.Errorf("OrthogonalVector() of the zero vector = %v, want the zero vector", got)
+	}
+}
+
+func TestVector3NearestPointOnLine(t *testing.T) {
+	tests := []struct {
+		name         string
+		point        Vector3
+		linePoint    Vector3
+		lineDir      Vector3
+		want         Vector3
+		wantDistance float64
+	}{
+		{"beside the Z axis", Vector3{X: 3, Y: 4, Z: 7}, Vector3{}, Vector3{Z: 1}, Vector3{Z: 7}, 5},
+		{"unnormalized direction", Vector3{X: 0, Y: 2, Z: -3}, Vector3{X: 1}, Vector3{X: 5}, Vector3{}, math.Sqrt(13)},
+		{"zero direction", Vector3{X: 1, Y: 2, Z: 2}, Vector3{}, Vector3{}, Vector3{}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.point.NearestPointOnLine(tt.linePoint, tt.lineDir); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("%v.NearestPointOnLine(%v, %v) = %v, want %v", tt.point, tt.linePoint, tt.lineDir, got, tt.want)
+			}
+
+			if got := tt.point.DistanceToLine(tt.linePoint, tt.lineDir); !approxEqual(got, tt.wantDistance, testEpsilon) {
+				t.Errorf("%v.DistanceToLine(%v, %v) = %v, want %v", tt.point, tt.linePoint, tt.lineDir, got, tt.wantDistance)
+			}
+		})
+	}
+}
+
+func TestVector3PackFloat32(t *testing.T) {
+	for _, v := range []Vector3{{X: 0, Y: 0, Z: 0}, {X: 1.5, Y: -2, Z: 0.25}, {X: 0.1, Y: 1e30, Z: -math.MaxFloat32}} {
+		packed := v.PackFloat32()
+		got := UnpackVector3Float32(packed)
+		want := Vector3{X: float64(float32(v.X)), Y: float64(float32(v.Y)), Z: float64(float32(v.Z))}
+
+		if !got.Equal(want) {
+			t.Errorf("PackFloat32 round trip of %v = %v, want %v", v, got, want)
+		}
+
+		if repacked := got.PackFloat32(); repacked != packed {
+			t.Errorf("repacking %v = %x, want %x", got, repacked, packed)
+		}
+	}
+}
+
+func TestVector3MortonCode(t *testing.T) {
+	tests := []struct {
+		name string
+		v    Vector3
+		want uint64
+	}{
+		{"origin", Vector3{}, 0},
+		{"X", Vector3{X: 1}, 1},
+		{"Y", Vector3{Y: 1}, 2},
+		{"Z", Vector3{Z: 1}, 4},
+		{"all", Vector3{X: 1, Y: 1, Z: 1}, 7},
+		{"next cell", Vector3{X: 2}, 8},
+		{"large clamps to 21 bits", Vector3{X: 1e9, Y: 1e9, Z: 1e9}, 1<<63 - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.MortonCode(1, 0); got != tt.want {
+				t.Errorf("%v.MortonCode(1, 0) = %d, want %d", tt.v, got, tt.want)
+			}
+		})
+	}
+
+	a := Vector3{X: 0.5, Y: 0.5, Z: 0.5}
+	b := Vector3{X: 1.5, Y: 0.5, Z: 0.5}
+	far := Vector3{X: 0.5, Y: 0.5, Z: 3.5}
+
+	if a.MortonCode(1, 0)>>3 != b.MortonCode(1, 0)>>3 {
+		t.Errorf("%v and %v are in the same 2x2x2 block but have different Morton prefixes", a, b)
+	}
+
+	if a.MortonCode(1, 0)>>3 == far.MortonCode(1, 0)>>3 {
+		t.Errorf("%v and %v are in different 2x2x2 blocks but share a Morton prefix", a, far)
+	}
+}
+
+func TestVector3Midpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Vector3
+		want Vector3
+	}{
+		{"same point", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 1, Y: 2, Z: 3}},
+		{"origin", Vector3{}, Vector3{X: 4, Y: -2, Z: 6}, Vector3{X: 2, Y: -1, Z: 3}},
+		{"mixed", Vector3{X: -3, Y: 5, Z: 0}, Vector3{X: 1, Y: 1, Z: -8}, Vector3{X: -1, Y: 3, Z: -4}},
+		{"opposite extremes", Vector3{X: math.MaxFloat64, Z: -math.MaxFloat64}, Vector3{X: -math.MaxFloat64, Z: math.MaxFloat64}, Vector3{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.a
+
+			if got := a.Midpoint(tt.b); !got.Equal(tt.want) {
+				t.Errorf("%v.Midpoint(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+
+			if a != tt.a {
+				t.Errorf("Midpoint() mutated the receiver to %v, want %v", a, tt.a)
+			}
+		})
+	}
+}
+
+func TestVector3WeightedMidpoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   Vector3
+		weight float64
+		want   Vector3
+	}{
+		{"weight 0", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 5, Y: -2, Z: 7}, 0, Vector3{X: 1, Y: 2, Z: 3}},
+		{"weight 1", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 5, Y: -2, Z: 7}, 1, Vector3{X: 5, Y: -2, Z: 7}},
+		{"weight 0.25", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 5, Y: -2, Z: 7}, 0.25, Vector3{X: 2, Y: 1, Z: 4}},
+		{"weight 0.5", Vector3{X: 1, Y: 2, Z: 3}, Vector3{X: 5, Y: -2, Z: 7}, 0.5, Vector3{X: 3, Y: 0, Z: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := tt.a
+
+			if got := a.WeightedMidpoint(tt.b, tt.weight); !got.Equal(tt.want) {
+				t.Errorf("%v.WeightedMidpoint(%v, %v) = %v, want %v", tt.a, tt.b, tt.weight, got, tt.want)
+			}
+
+			if a != tt.a {
+				t.Errorf("WeightedMidpoint() mutated the receiver to %v, want %v", a, tt.a)
+			}
+		})
+	}
+}
+
+func TestWeightedAverageVector3(t *testing.T) {
+	tests := []struct {
+		name    string
+		vecs    []Vector3
+		weights []float64
+		want    Vector3
+		wantErr error
+	}{
+		{"equal weights", []Vector3{{}, {X: 4, Y: 2, Z: -6}}, []float64{1, 1}, Vector3{X: 2, Y: 1, Z: -3}, nil},
+		{"uneven weights", []Vector3{{}, {X: 4, Y: 2, Z: -6}}, []float64{3, 1}, Vector3{X: 1, Y: 0.5, Z: -1.5}, nil},
+		{"single vector", []Vector3{{X: -1, Y: 7, Z: 2}}, []float64{0.25}, Vector3{X: -1, Y: 7, Z: 2}, nil},
+		{"negative weight", []Vector3{{X: 1}, {Z: 1}}, []float64{2, -1}, Vector3{X: 2, Z: -1}, nil},
+		{"length mismatch", []Vector3{{X: 1}}, []float64{1, 1}, Vector3{}, ErrLengthMismatch},
+		{"empty", []Vector3{}, []float64{}, Vector3{}, ErrZeroTotalWeight},
+		{"all zero weights", []Vector3{{X: 1}, {Z: 1}}, []float64{0, 0}, Vector3{}, ErrZeroTotalWeight},
+		{"cancelling weights", []Vector3{{X: 1}, {Z: 1}}, []float64{1, -1}, Vector3{}, ErrZeroTotalWeight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WeightedAverageVector3(tt.vecs, tt.weights)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WeightedAverageVector3(%v, %v) error = %v, want %v", tt.vecs, tt.weights, err, tt.wantErr)
+			}
+
+			if !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("WeightedAverageVector3(%v, %v) = %v, want %v", tt.vecs, tt.weights, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBiLerpVector3(t *testing.T) {
+	a := Vector3{}
+	b := Vector3{X: 4}
+	c := Vector3{Y: 2}
+	d := Vector3{X: 6, Y: 4, Z: 8}
+
+	tests := []struct {
+		name string
+		s, t float64
+		want Vector3
+	}{
+		{"corner a", 0, 0, a},
+		{"corner b", 1, 0, b},
+		{"corner c", 0, 1, c},
+		{"corner d", 1, 1, d},
+		{"center", 0.5, 0.5, Vector3{X: 2.5, Y: 1.5, Z: 2}},
+		{"top edge", 0.5, 1, Vector3{X: 3, Y: 3, Z: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BiLerpVector3(a, b, c, d, tt.s, tt.t); !got.ApproxEqual(tt.want, testEpsilon) {
+				t.Errorf("BiLerpVector3(%v, %v) = %v, want %v", tt.s, tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3Clamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         Vector3
+		minValue      Vector3
+		maxValue      Vector3
+		want          Vector3
+		wantPanicAxis string
+	}{
+		{"inside", Vector3{X: 1, Y: 2, Z: 3}, Vector3{}, Vector3{X: 5, Y: 5, Z: 5}, Vector3{X: 1, Y: 2, Z: 3}, ""},
+		{"below", Vector3{X: -3, Y: -1, Z: -2}, Vector3{Y: -0.5, Z: -1}, Vector3{X: 5, Y: 5, Z: 5}, Vector3{Y: -0.5, Z: -1}, ""},
+		{"above", Vector3{X: 9, Y: 7, Z: 8}, Vector3{}, Vector3{X: 5, Y: 6, Z: 7}, Vector3{X: 5, Y: 6, Z: 7}, ""},
+		{"mixed", Vector3{X: -9, Y: 9, Z: 0.5}, Vector3{X: -1, Y: -1, Z: -1}, Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: -1, Y: 1, Z: 0.5}, ""},
+		{"equal bounds", Vector3{X: 3, Y: -3, Z: 0}, Vector3{X: 2, Y: 2, Z: 2}, Vector3{X: 2, Y: 2, Z: 2}, Vector3{X: 2, Y: 2, Z: 2}, ""},
+		{"inverted X", Vector3{X: 1, Y: 1, Z: 1}, Vector3{X: 5}, Vector3{Y: 5, Z: 5}, Vector3{}, "X"},
+		{"inverted Y", Vector3{X: 1, Y: 1, Z: 1}, Vector3{Y: 5}, Vector3{X: 5, Z: 5}, Vector3{}, "Y"},
+		{"inverted Z", Vector3{X: 1, Y: 1, Z: 1}, Vector3{Z: 5}, Vector3{X: 5, Y: 5}, Vector3{}, "Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+
+				if tt.wantPanicAxis == "" {
+					if r != nil {
+						t.Errorf("Clamp(%v, %v) panicked: %v", tt.minValue, tt.maxValue, r)
+					}
+
+					return
+				}
+
+				if msg, _ := r.(string); !strings.Contains(msg, tt.wantPanicAxis+" axis") {
+					t.Errorf("Clamp(%v, %v) panic = %v, want it to name the %s axis", tt.minValue, tt.maxValue, r, tt.wantPanicAxis)
+				}
+			}()
+
+			got := tt.input
+			got.Clamp(tt.minValue, tt.maxValue)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("%v.Clamp(%v, %v) = %v, want %v", tt.input, tt.minValue, tt.maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3ClampScalar(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Vector3
+		minValue  float64
+		maxValue  float64
+		want      Vector3
+		wantPanic bool
+	}{
+		{"inside", Vector3{X: 0.5, Y: 0.25, Z: 1}, 0, 1, Vector3{X: 0.5, Y: 0.25, Z: 1}, false},
+		{"outside", Vector3{X: -2, Y: 3, Z: 0.5}, 0, 1, Vector3{X: 0, Y: 1, Z: 0.5}, false},
+		{"equal bounds", Vector3{X: -2, Y: 3, Z: 1}, 1, 1, Vector3{X: 1, Y: 1, Z: 1}, false},
+		{"inverted", Vector3{X: 0.5, Y: 0.5, Z: 0.5}, 1, 0, Vector3{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("ClampScalar(%v, %v) panicked = %v, want %v", tt.minValue, tt.maxValue, r != nil, tt.wantPanic)
+				}
+			}()
+
+			got := tt.input
+			got.ClampScalar(tt.minValue, tt.maxValue)
+
+			if !got.Equal(tt.want) {
+				t.Errorf("%v.ClampScalar(%v, %v) = %v, want %v", tt.input, tt.minValue, tt.maxValue, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3GetSet(t *testing.T) {
+	v := Vector3{X: 1.5, Y: -2, Z: 4}
+	want := []float64{1.5, -2, 4}
+
+	if got := v.Len(); got != len(want) {
+		t.Fatalf("%v.Len() = %d, want %d", v, got, len(want))
+	}
+
+	for i := range v.Len() {
+		if got := v.Get(i); got != want[i] {
+			t.Errorf("%v.Get(%d) = %v, want %v", v, i, got, want[i])
+		}
+
+		var set Vector3
+		set.Set(i, want[i])
+
+		if got := set.Get(i); got != want[i] {
+			t.Errorf("Get(%d) after Set(%d, %v) = %v, want %v", i, i, want[i], got, want[i])
+		}
+
+		for j := range set.Len() {
+			if j != i && set.Get(j) != 0 {
+				t.Errorf("Set(%d, %v) changed index %d to %v", i, want[i], j, set.Get(j))
+			}
+		}
+	}
+
+	for _, i := range []int{-1, v.Len()} {
+		t.Run(fmt.Sprintf("index %d", i), func(t *testing.T) {
+			for name, access := range map[string]func(){
+				"Get": func() { v.Get(i) },
+				"Set": func() { v.Set(i, 1) },
+			} {
+				func() {
+					defer func() {
+						if msg, _ := recover().(string); !strings.Contains(msg, "out of range") {
+							t.Errorf("%s(%d) panic = %q, want an out of range panic", name, i, msg)
+						}
+					}()
+
+					access()
+				}()
+			}
+		})
+	}
+}
+
+func TestVector3Components(t *testing.T) {
+	v := Vector3{X: 1.5, Y: -2, Z: 4}
+	components := v.Components()
+
+	if want := []float64{1.5, -2, 4}; !slices.Equal(components, want) {
+		t.Fatalf("%v.Components() = %v, want %v", v, components, want)
+	}
+
+	components[0] = 100
+
+	if v.X != 1.5 {
+		t.Errorf("modifying the result of Components() changed the vector to %v", v)
+	}
+
+	if again := v.Components(); again[0] != 1.5 {
+		t.Errorf("Components() returned a shared slice, got %v after modifying an earlier result", again)
+	}
+}
@@ -0,0 +1,34 @@
+package vectors
+
+// IVector3f32 is the interface for a 3D vector with float32 components.
+type IVector3f32 = IVec3[float32]
+
+// Vector3f32 is a 3D vector with float32 X, Y, and Z coordinates,
+// for applications that need float32 precision, such as GPU-facing code.
+type Vector3f32 = Vec3[float32]
+
+// NewVector3f32 creates a new 3D float32 vector from its coordinates.
+func NewVector3f32(x, y, z float32) Vector3f32 {
+	return Vector3f32{
+		X: x,
+		Y: y,
+		Z: z,
+	}
+}
+
+// Vector3ToFloat32 converts a Vector3 to a Vector3f32, rounding each component to the nearest float32.
+// It is shorthand for Vec3FromVector3[float32].
+func Vector3ToFloat32(v Vector3) Vector3f32 {
+	return Vec3FromVector3[float32](v)
+}
+
+// Vector3FromFloat32 converts a Vector3f32 to a Vector3.
+// It is shorthand for Vector3FromVec3[float32].
+func Vector3FromFloat32(v Vector3f32) Vector3 {
+	return Vector3FromVec3(v)
+}
+
+// ToGLArray returns the coordinates of the vector as a float32 array, for use with OpenGL bindings.
+func (v Vec3[T]) ToGLArray() [3]float32 {
+	return v.ToFloat32Array()
+}
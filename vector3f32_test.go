@@ -0,0 +1,52 @@
+package vectors
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVector3ToFloat32(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Vector3
+		want  Vector3f32
+	}{
+		{"exact", Vector3{X: 1.5, Y: -2.25, Z: 8}, NewVector3f32(1.5, -2.25, 8)},
+		{"rounds to nearest", Vector3{X: 0.1, Y: 1 + 1e-9, Z: 1.0 / 3}, NewVector3f32(0.1, 1, 1.0/3)},
+		{"overflows to infinity", Vector3{X: 1e39, Y: -1e39, Z: 1}, NewVector3f32(float32(math.Inf(1)), float32(math.Inf(-1)), 1)},
+		{"underflows to zero", Vector3{X: 1e-50, Y: -1e-50, Z: 1}, NewVector3f32(0, 0, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Vector3ToFloat32(tt.input)
+
+			if got != tt.want {
+				t.Errorf("Vector3ToFloat32(%v) = %v, want %v", tt.input, got, tt.want)
+			}
+
+			if generic := Vec3FromVector3[float32](tt.input); got != generic {
+				t.Errorf("Vector3ToFloat32(%v) = %v, but Vec3FromVector3[float32] = %v", tt.input, got, generic)
+			}
+
+			if gl := got.ToGLArray(); gl != [3]float32{got.X, got.Y, got.Z} {
+				t.Errorf("%v.ToGLArray() = %v, want %v", got, gl, [3]float32{got.X, got.Y, got.Z})
+			}
+		})
+	}
+}
+
+func TestVector3FromFloat32(t *testing.T) {
+	// Widening is exact, so narrowing a widened vector gives back the original.
+	for _, v := range []Vector3f32{NewVector3f32(0.1, -3, 1e-3), NewVector3f32(math.MaxFloat32, math.SmallestNonzeroFloat32, 0)} {
+		got := Vector3FromFloat32(v)
+
+		if want := (Vector3{X: float64(v.X), Y: float64(v.Y), Z: float64(v.Z)}); got != want {
+			t.Errorf("Vector3FromFloat32(%v) = %v, want %v", v, got, want)
+		}
+
+		if back := Vector3ToFloat32(got); back != v {
+			t.Errorf("Vector3ToFloat32(Vector3FromFloat32(%v)) = %v, want %v", v, back, v)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package vectors
+
+import (
+	"math"
+)
+
+// IVector3i is the interface for a 3D integer vector.
+type IVector3i interface {
+	Add(vec Vector3i)
+	Sub(vec Vector3i)
+	Mul(vec Vector3i)
+	Div(vec Vector3i)
+	Scale(scale int)
+	Bounce()
+	IsZero() bool
+	Magnitude() float64
+	MagnitudeSquared() int
+	Distance(vec Vector3i) float64
+	DistanceSquared(vec Vector3i) int
+	Dot(vec Vector3i) int
+	Clear()
+	ToVector3() Vector3
+}
+
+// Vector3i represents a 3D vector with integer X, Y, and Z coordinates.
+// It provides methods for vector operations on grids.
+type Vector3i struct {
+	X int
+	Y int
+	Z int
+}
+
+var _ IVector3i = (*Vector3i)(nil)
+
+// NewVector3i creates a new 3D integer vector from its coordinates.
+func NewVector3i(x, y, z int) Vector3i {
+	return Vector3i{
+		X: x,
+		Y: y,
+		Z: z,
+	}
+}
+
+// Add adds the values of another vector to this one.
+func (v *Vector3i) Add(vec Vector3i) {
+	v.X += vec.X
+	v.Y += vec.Y
+	v.Z += vec.Z
+}
+
+// Sub subtracts the values of another vector from this one.
+func (v *Vector3i) Sub(vec Vector3i) {
+	v.X -= vec.X
+	v.Y -= vec.Y
+	v.Z -= vec.Z
+}
+
+// Mul multiplies this vector by another vector.
+func (v *Vector3i) Mul(vec Vector3i) {
+	v.X *= vec.X
+	v.Y *= vec.Y
+	v.Z *= vec.Z
+}
+
+// Div divides this vector by another vector, truncating toward zero.
+// It panics if any component of the other vector is zero.
+func (v *Vector3i) Div(vec Vector3i) {
+	if vec.X == 0 {
+		panic("vectors: Vector3i division by zero on the X axis")
+	}
+
+	if vec.Y == 0 {
+		panic("vectors: Vector3i division by zero on the Y axis")
+	}
+
+	if vec.Z == 0 {
+		panic("vectors: Vector3i division by zero on the Z axis")
+	}
+
+	v.X /= vec.X
+	v.Y /= vec.Y
+	v.Z /= vec.Z
+}
+
+// Scale multiplies this vector by a scale.
+func (v *Vector3i) Scale(scale int) {
+	v.X *= scale
+	v.Y *= scale
+	v.Z *= scale
+}
+
+// Bounce inverts the direction of the vector.
+func (v *Vector3i) Bounce() {
+	v.X = -v.X
+	v.Y = -v.Y
+	v.Z = -v.Z
+}
+
+// IsZero checks if all axes are zero.
+func (v Vector3i) IsZero() bool {
+	return v.X == 0 && v.Y == 0 && v.Z == 0
+}
+
+// Magnitude returns the length of the vector.
+func (v Vector3i) Magnitude() float64 {
+	return math.Sqrt(float64(v.MagnitudeSquared()))
+}
+
+// MagnitudeSquared returns the squared magnitude of the vector.
+func (v Vector3i) MagnitudeSquared() int {
+	return (v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z)
+}
+
+// Distance returns the distance between this vector and another vector.
+func (v Vector3i) Distance(vec Vector3i) float64 {
+	return math.Sqrt(float64(v.DistanceSquared(vec)))
+}
+
+// DistanceSquared returns the squared distance between this vector and another vector.
+func (v Vector3i) DistanceSquared(vec Vector3i) int {
+	dx := v.X - vec.X
+	dy := v.Y - vec.Y
+	dz := v.Z - vec.Z
+	return dx*dx + dy*dy + dz*dz
+}
+
+// Dot returns the dot product.
+// Positive = same direction, negative = opposite, zero = perpendicular.
+func (v Vector3i) Dot(vec Vector3i) int {
+	return v.X*vec.X + v.Y*vec.Y + v.Z*vec.Z
+}
+
+// Clear sets the vector to zero.
+func (v *Vector3i) Clear() {
+	v.X = 0
+	v.Y = 0
+	v.Z = 0
+}
+
+// ToVector3 converts the integer vector to a floating-point vector.
+func (v Vector3i) ToVector3() Vector3 {
+	return Vector3{
+		X: float64(v.X),
+		Y: float64(v.Y),
+		Z: float64(v.Z),
+	}
+}
@@ -0,0 +1,138 @@
+package vectors
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVector3iArithmetic(t *testing.T) {
+	a := NewVector3i(3, -4, 2)
+	b := NewVector3i(-2, 5, 1)
+
+	tests := []struct {
+		name string
+		op   func(v *Vector3i)
+		want Vector3i
+	}{
+		{"Add", func(v *Vector3i) { v.Add(b) }, Vector3i{X: 1, Y: 1, Z: 3}},
+		{"Sub", func(v *Vector3i) { v.Sub(b) }, Vector3i{X: 5, Y: -9, Z: 1}},
+		{"Mul", func(v *Vector3i) { v.Mul(b) }, Vector3i{X: -6, Y: -20, Z: 2}},
+		{"Scale", func(v *Vector3i) { v.Scale(-3) }, Vector3i{X: -9, Y: 12, Z: -6}},
+		{"Bounce", func(v *Vector3i) { v.Bounce() }, Vector3i{X: -3, Y: 4, Z: -2}},
+		{"Clear", func(v *Vector3i) { v.Clear() }, Vector3i{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := a
+			tt.op(&got)
+
+			if got != tt.want {
+				t.Errorf("%v.%s() = %v, want %v", a, tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3iMeasurements(t *testing.T) {
+	a := Vector3i{X: 2, Y: -3, Z: 6}
+	b := Vector3i{X: 0, Y: -1, Z: 1}
+
+	if got := a.MagnitudeSquared(); got != 49 {
+		t.Errorf("%v.MagnitudeSquared() = %d, want 49", a, got)
+	}
+
+	if got := a.Magnitude(); got != 7 {
+		t.Errorf("%v.Magnitude() = %v, want 7", a, got)
+	}
+
+	if got := a.DistanceSquared(b); got != 33 {
+		t.Errorf("%v.DistanceSquared(%v) = %d, want 33", a, b, got)
+	}
+
+	if got := a.Distance(Vector3i{X: 2, Y: 1, Z: 3}); got != 5 {
+		t.Errorf("%v.Distance((2, 1, 3)) = %v, want 5", a, got)
+	}
+
+	if got := a.Dot(b); got != 9 {
+		t.Errorf("%v.Dot(%v) = %d, want 9", a, b, got)
+	}
+
+	if a.IsZero() || !(Vector3i{}).IsZero() {
+		t.Errorf("IsZero() is wrong for %v or the zero vector", a)
+	}
+}
+
+func TestVector3iDiv(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         Vector3i
+		divisor       Vector3i
+		want          Vector3i
+		wantPanicAxis string
+	}{
+		{"exact", Vector3i{X: 6, Y: -8, Z: 9}, Vector3i{X: 3, Y: 2, Z: -3}, Vector3i{X: 2, Y: -4, Z: -3}, ""},
+		{"truncates positive", Vector3i{X: 7, Y: 5, Z: 1}, Vector3i{X: 2, Y: 3, Z: 2}, Vector3i{X: 3, Y: 1, Z: 0}, ""},
+		{"truncates toward zero", Vector3i{X: -7, Y: 7, Z: -1}, Vector3i{X: 2, Y: -3, Z: 2}, Vector3i{X: -3, Y: -2, Z: 0}, ""},
+		{"zero X", Vector3i{X: 1, Y: 1, Z: 1}, Vector3i{X: 0, Y: 1, Z: 1}, Vector3i{}, "X"},
+		{"zero Y", Vector3i{X: 1, Y: 1, Z: 1}, Vector3i{X: 1, Y: 0, Z: 1}, Vector3i{}, "Y"},
+		{"zero Z", Vector3i{X: 1, Y: 1, Z: 1}, Vector3i{X: 1, Y: 1, Z: 0}, Vector3i{}, "Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				r := recover()
+
+				if tt.wantPanicAxis == "" {
+					if r != nil {
+						t.Errorf("Div(%v) panicked: %v", tt.divisor, r)
+					}
+
+					return
+				}
+
+				if msg, _ := r.(string); !strings.Contains(msg, "division by zero on the "+tt.wantPanicAxis+" axis") {
+					t.Errorf("Div(%v) panic = %v, want a division by zero on the %s axis", tt.divisor, r, tt.wantPanicAxis)
+				}
+			}()
+
+			got := tt.input
+			got.Div(tt.divisor)
+
+			if got != tt.want {
+				t.Errorf("%v.Div(%v) = %v, want %v", tt.input, tt.divisor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3iConversion(t *testing.T) {
+	for _, v := range []Vector3i{{}, {X: 3, Y: -4, Z: 5}, {X: -1 << 40, Y: 1 << 40, Z: 7}} {
+		if got := v.ToVector3().ToVector3i(); got != v {
+			t.Errorf("%v.ToVector3().ToVector3i() = %v, want %v", v, got, v)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		input Vector3
+		want  Vector3i
+	}{
+		{"integers", Vector3{X: 2, Y: -3, Z: 4}, Vector3i{X: 2, Y: -3, Z: 4}},
+		{"truncates toward zero", Vector3{X: 2.9, Y: -2.9, Z: 0.1}, Vector3i{X: 2, Y: -2, Z: 0}},
+		{"small fractions", Vector3{X: 0.5, Y: -0.5, Z: 0.999}, Vector3i{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.ToVector3i(); got != tt.want {
+				t.Errorf("%v.ToVector3i() = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	if got, want := (Vector3i{X: 3, Y: -4, Z: 5}).ToVector3(), (Vector3{X: 3, Y: -4, Z: 5}); got != want {
+		t.Errorf("Vector3i{3, -4, 5}.ToVector3() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,280 @@
+package vectors
+
+import (
+	"fmt"
+	"math"
+)
+
+// IVector4 is the interface for a 4D vector.
+type IVector4 interface {
+	Add(vec Vector4)
+	Added(vec Vector4) Vector4
+	Sub(vec Vector4)
+	Subbed(vec Vector4) Vector4
+	Mul(vec Vector4)
+	Muled(vec Vector4) Vector4
+	Div(vec Vector4)
+	Dived(vec Vector4) Vector4
+	Scale(scale float64)
+	Scaled(scale float64) Vector4
+	Bounce()
+	Normalize()
+	Normalized() Vector4
+	AngleRadians() float64
+	AngleDegrees() float64
+	IsZero() bool
+	Magnitude() float64
+	MagnitudeSquared() float64
+	Distance(vec Vector4) float64
+	DistanceSquared(vec Vector4) float64
+	Dot(vec Vector4) float64
+	Lerp(vec Vector4, t float64)
+	ClampMagnitude(maxValue float64)
+	Clear()
+	String() string
+	GoString() string
+	ToVector3() Vector3
+	ToVector2() Vector2
+}
+
+// Vector4 represents a 4D vector with X, Y, Z, and W coordinates.
+// It provides methods for vector operations.
+type Vector4 struct {
+	X float64
+	Y float64
+	Z float64
+	W float64
+}
+
+var _ IVector4 = (*Vector4)(nil)
+
+// NewVector4 creates a new 4D vector from its coordinates.
+func NewVector4(x, y, z, w float64) Vector4 {
+	return Vector4{
+		X: x,
+		Y: y,
+		Z: z,
+		W: w,
+	}
+}
+
+// Add adds the values of another vector to this one.
+func (v *Vector4) Add(vec Vector4) {
+	v.X += vec.X
+	v.Y += vec.Y
+	v.Z += vec.Z
+	v.W += vec.W
+}
+
+// Added returns a copy of this vector with the values of another vector added to it.
+func (v Vector4) Added(vec Vector4) Vector4 {
+	v.Add(vec)
+
+	return v
+}
+
+// Sub subtracts the values of another vector from this one.
+func (v *Vector4) Sub(vec Vector4) {
+	v.X -= vec.X
+	v.Y -= vec.Y
+	v.Z -= vec.Z
+	v.W -= vec.W
+}
+
+// Subbed returns a copy of this vector with the values of another vector subtracted from it.
+func (v Vector4) Subbed(vec Vector4) Vector4 {
+	v.Sub(vec)
+
+	return v
+}
+
+// Mul multiplies this vector by another vector.
+func (v *Vector4) Mul(vec Vector4) {
+	v.X *= vec.X
+	v.Y *= vec.Y
+	v.Z *= vec.Z
+	v.W *= vec.W
+}
+
+// Muled returns a copy of this vector multiplied by another vector.
+func (v Vector4) Muled(vec Vector4) Vector4 {
+	v.Mul(vec)
+
+	return v
+}
+
+// Div divides this vector by another vector.
+func (v *Vector4) Div(vec Vector4) {
+	v.X /= vec.X
+	v.Y /= vec.Y
+	v.Z /= vec.Z
+	v.W /= vec.W
+}
+
+// Dived returns a copy of this vector divided by another vector.
+func (v Vector4) Dived(vec Vector4) Vector4 {
+	v.Div(vec)
+
+	return v
+}
+
+// Scale multiplies this vector by a scale.
+func (v *Vector4) Scale(scale float64) {
+	v.X *= scale
+	v.Y *= scale
+	v.Z *= scale
+	v.W *= scale
+}
+
+// Scaled returns a copy of this vector multiplied by a scale.
+func (v Vector4) Scaled(scale float64) Vector4 {
+	v.Scale(scale)
+
+	return v
+}
+
+// Bounce inverts the direction of the vector.
+func (v *Vector4) Bounce() {
+	v.X = -v.X
+	v.Y = -v.Y
+	v.Z = -v.Z
+	v.W = -v.W
+}
+
+// Normalize scales the vector to have a magnitude of 1.
+func (v *Vector4) Normalize() {
+	magnitudeSquared := v.X*v.X + v.Y*v.Y + v.Z*v.Z + v.W*v.W
+
+	if magnitudeSquared != 0 {
+		magnitude := math.Sqrt(magnitudeSquared)
+		v.X /= magnitude
+		v.Y /= magnitude
+		v.Z /= magnitude
+		v.W /= magnitude
+	}
+}
+
+// Normalized returns a copy of this vector with a magnitude of 1.
+func (v Vector4) Normalized() Vector4 {
+	v.Normalize()
+
+	return v
+}
+
+// AngleRadians returns the angle in radians.
+// This method ignores the Z and W axes and projects the vector onto the XY plane.
+func (v Vector4) AngleRadians() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// AngleDegrees returns the angle of the vector in degrees.
+// This method ignores the Z and W axes and projects the vector onto the XY plane.
+func (v Vector4) AngleDegrees() float64 {
+	angle := math.Atan2(v.Y, v.X) * 180 / math.Pi
+
+	if angle < 0 {
+		angle += 360
+	}
+
+	return angle
+}
+
+// IsZero checks if all axes are zero.
+func (v Vector4) IsZero() bool {
+	return v.X == 0 && v.Y == 0 && v.Z == 0 && v.W == 0
+}
+
+// Magnitude returns the length of the vector.
+func (v Vector4) Magnitude() float64 {
+	return math.Sqrt((v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z) + (v.W * v.W))
+}
+
+// MagnitudeSquared returns the squared magnitude of the vector.
+func (v Vector4) MagnitudeSquared() float64 {
+	return (v.X * v.X) + (v.Y * v.Y) + (v.Z * v.Z) + (v.W * v.W)
+}
+
+// Distance returns the distance between this vector and another vector.
+func (v Vector4) Distance(vec Vector4) float64 {
+	dx := v.X - vec.X
+	dy := v.Y - vec.Y
+	dz := v.Z - vec.Z
+	dw := v.W - vec.W
+
+	return math.Sqrt(dx*dx + dy*dy + dz*dz + dw*dw)
+}
+
+// DistanceSquared returns the squared distance between this vector and another vector.
+func (v Vector4) DistanceSquared(vec Vector4) float64 {
+	dx := v.X - vec.X
+	dy := v.Y - vec.Y
+	dz := v.Z - vec.Z
+	dw := v.W - vec.W
+	return dx*dx + dy*dy + dz*dz + dw*dw
+}
+
+// Dot returns the dot product.
+// Positive = same direction, negative = opposite, zero = perpendicular.
+func (v Vector4) Dot(vec Vector4) float64 {
+	return v.X*vec.X + v.Y*vec.Y + v.Z*vec.Z + v.W*vec.W
+}
+
+// Lerp interpolates between this vector and another vector.
+func (v *Vector4) Lerp(vec Vector4, t float64) {
+	v.X += (vec.X - v.X) * t
+	v.Y += (vec.Y - v.Y) * t
+	v.Z += (vec.Z - v.Z) * t
+	v.W += (vec.W - v.W) * t
+}
+
+// ClampMagnitude limits the magnitude of the vector to a maximum value.
+func (v *Vector4) ClampMagnitude(maxValue float64) {
+	maxSquared := maxValue * maxValue
+	magnitudeSquared := v.MagnitudeSquared()
+
+	if magnitudeSquared == 0 || magnitudeSquared <= maxSquared {
+		return
+	}
+
+	scale := maxValue / math.Sqrt(magnitudeSquared)
+	v.X *= scale
+	v.Y *= scale
+	v.Z *= scale
+	v.W *= scale
+}
+
+// Clear sets the vector to zero.
+func (v *Vector4) Clear() {
+	v.X = 0
+	v.Y = 0
+	v.Z = 0
+	v.W = 0
+}
+
+// String returns the vector formatted as "(x, y, z, w)".
+func (v Vector4) String() string {
+	return "(" + formatComponent(v.X) + ", " + formatComponent(v.Y) + ", " +
+		formatComponent(v.Z) + ", " + formatComponent(v.W) + ")"
+}
+
+// GoString returns the vector formatted as a Go expression, for use with %#v.
+func (v Vector4) GoString() string {
+	return fmt.Sprintf("vectors.Vector4{X: %#v, Y: %#v, Z: %#v, W: %#v}", v.X, v.Y, v.Z, v.W)
+}
+
+// ToVector3 converts the 4D vector to a 3D vector.
+func (v Vector4) ToVector3() Vector3 {
+	return Vector3{
+		X: v.X,
+		Y: v.Y,
+		Z: v.Z,
+	}
+}
+
+// ToVector2 converts the 4D vector to a 2D vector.
+func (v Vector4) ToVector2() Vector2 {
+	return Vector2{
+		X: v.X,
+		Y: v.Y,
+	}
+}
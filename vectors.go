@@ -1,6 +1,265 @@
-// Package vectors provides 2D and 3D vector types with mathematical operations.
+// Package vectors provides 2D, 3D, and 4D vector types with mathematical operations.
 //
 // The package includes:
-//   - Vector2: 2D vector with X, Y coordinates
-//   - Vector3: 3D vector with X, Y, Z coordinates
+//   - Vec2: generic 2D vector with X, Y coordinates of any float type
+//   - Vec3: generic 3D vector with X, Y, Z coordinates of any float type
+//   - Vector2, Vector3: aliases of Vec2 and Vec3 with float64 coordinates
+//   - Vector2f32, Vector3f32: aliases of Vec2 and Vec3 with float32 coordinates
+//   - Vector4: 4D vector with X, Y, Z, W coordinates
+//   - Vector2i: 2D integer vector with X, Y coordinates
+//   - Vector3i: 3D integer vector with X, Y, Z coordinates
+//   - Plane: infinite plane in 3D space
+//   - Ray2D, Ray3D: half-lines in 2D and 3D space
+//   - AABB2D, AABB3D: axis-aligned bounding boxes in 2D and 3D space
+//   - Triangle2D: triangle in 2D space
 package vectors
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// NormalizationEpsilon is the tolerance used when checking if a vector is normalized.
+// It is compared against the difference between the squared magnitude and 1.
+const NormalizationEpsilon = 1e-9
+
+// NormalizationEpsilon32 is the tolerance used when checking if a float32 vector is normalized.
+// It is larger than NormalizationEpsilon to account for the lower precision of float32.
+const NormalizationEpsilon32 = 1e-6
+
+// trimVectorDelimiters removes surrounding whitespace and one matching pair of
+// parentheses or brackets from a vector string.
+func trimVectorDelimiters(s string) string {
+	s = strings.TrimSpace(s)
+
+	if len(s) >= 2 &&
+		((s[0] == '(' && s[len(s)-1] == ')') || (s[0] == '[' && s[len(s)-1] == ']')) {
+		s = s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// Float is the constraint for the component types of the generic vector types.
+type Float interface {
+	~float32 | ~float64
+}
+
+// bitSize returns the size of the float type T in bits.
+func bitSize[T Float]() int {
+	var zero T
+
+	return int(unsafe.Sizeof(zero)) * 8
+}
+
+// appendBinaryFloat appends a float as little-endian IEEE 754 bytes, using 4 bytes for float32 and 8 for float64.
+func appendBinaryFloat[T Float](data []byte, f T) []byte {
+	if bitSize[T]() == 32 {
+		return binary.LittleEndian.AppendUint32(data, math.Float32bits(float32(f)))
+	}
+
+	return binary.LittleEndian.AppendUint64(data, math.Float64bits(float64(f)))
+}
+
+// readBinaryFloat reads a float from little-endian IEEE 754 bytes, using 4 bytes for float32 and 8 for float64.
+func readBinaryFloat[T Float](data []byte) T {
+	if bitSize[T]() == 32 {
+		return T(math.Float32frombits(binary.LittleEndian.Uint32(data)))
+	}
+
+	return T(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+}
+
+// formatComponent formats a vector component in its shortest exact representation.
+// Whole numbers get a trailing ".0", so they read as floats, such as "4.0" instead of "4".
+func formatComponent[T Float](x T) string {
+	s := strconv.FormatFloat(float64(x), 'g', -1, bitSize[T]())
+
+	if strings.Trim(s, "-0123456789") == "" {
+		s += ".0"
+	}
+
+	return s
+}
+
+// wrapFloat wraps a value into the range [minValue, maxValue).
+// If the range is empty, minValue is returned.
+func wrapFloat[T Float](value, minValue, maxValue T) T {
+	size := maxValue - minValue
+
+	if size == 0 {
+		return minValue
+	}
+
+	offset := T(math.Mod(float64(value-minValue), float64(size)))
+
+	if offset < 0 {
+		offset += size
+	}
+
+	if offset >= size {
+		offset = 0
+	}
+
+	return minValue + offset
+}
+
+// toroidalDelta returns the shortest absolute difference between two values on an axis that wraps around at size.
+func toroidalDelta[T Float](a, b, size T) T {
+	delta := T(math.Mod(math.Abs(float64(a-b)), float64(size)))
+
+	return min(delta, size-delta)
+}
+
+// stepFloat returns 0 if a value is less than an edge, and 1 otherwise.
+func stepFloat[T Float](edge, value T) T {
+	if value < edge {
+		return 0
+	}
+
+	return 1
+}
+
+// smoothStepFloat returns 0 at or below edge0, 1 at or above edge1, and follows the cubic 3t²-2t³ curve in between.
+// If both edges are equal, it behaves like stepFloat.
+func smoothStepFloat[T Float](edge0, edge1, value T) T {
+	if edge0 == edge1 {
+		return stepFloat(edge0, value)
+	}
+
+	t := max(0, min(1, (value-edge0)/(edge1-edge0)))
+
+	return t * t * (3 - 2*t)
+}
+
+// quantizeFloat rounds a value to the nearest multiple of 1/scale.
+// If the scale is zero or not finite, or the scaled value overflows, the value is returned unchanged.
+func quantizeFloat[T Float](value, scale T) T {
+	scaled := value * scale
+
+	if scale == 0 || isInf(scale) || isNaN(scaled) || isInf(scaled) {
+		return value
+	}
+
+	return round(scaled) / scale
+}
+
+// mortonQuantize maps a value to an unsigned integer of the given bit width for use in a Morton code.
+// The value is offset, scaled, floored, and clamped to the representable range. NaN maps to 0.
+func mortonQuantize(value, scale, offset float64, bits uint) uint64 {
+	maxValue := float64(uint64(1)<<bits - 1)
+	q := math.Floor((value + offset) * scale)
+
+	if !(q > 0) {
+		return 0
+	}
+
+	if q >= maxValue {
+		return uint64(maxValue)
+	}
+
+	return uint64(q)
+}
+
+// spreadBits2 spreads the lower 16 bits of a value so that there is one zero bit between each of them.
+func spreadBits2(x uint64) uint64 {
+	x &= 0xffff
+	x = (x | x<<8) & 0x00ff00ff
+	x = (x | x<<4) & 0x0f0f0f0f
+	x = (x | x<<2) & 0x33333333
+	x = (x | x<<1) & 0x55555555
+
+	return x
+}
+
+// spreadBits3 spreads the lower 21 bits of a value so that there are two zero bits between each of them.
+func spreadBits3(x uint64) uint64 {
+	x &= 0x1fffff
+	x = (x | x<<32) & 0x1f00000000ffff
+	x = (x | x<<16) & 0x1f0000ff0000ff
+	x = (x | x<<8) & 0x100f00f00f00f00f
+	x = (x | x<<4) & 0x10c30c30c30c30c3
+	x = (x | x<<2) & 0x1249249249249249
+
+	return x
+}
+
+// sqrt returns the square root of a value of any float type, computed in float64.
+func sqrt[T Float](x T) T {
+	return T(math.Sqrt(float64(x)))
+}
+
+// abs returns the absolute value of a value of any float type.
+func abs[T Float](x T) T {
+	return T(math.Abs(float64(x)))
+}
+
+// floor returns the greatest integer value less than or equal to a value of any float type.
+func floor[T Float](x T) T {
+	return T(math.Floor(float64(x)))
+}
+
+// ceil returns the least integer value greater than or equal to a value of any float type.
+func ceil[T Float](x T) T {
+	return T(math.Ceil(float64(x)))
+}
+
+// round returns the nearest integer to a value of any float type, rounding half away from zero.
+func round[T Float](x T) T {
+	return T(math.Round(float64(x)))
+}
+
+// trunc returns the integer value of a value of any float type, rounding towards zero.
+func trunc[T Float](x T) T {
+	return T(math.Trunc(float64(x)))
+}
+
+// pow returns x raised to the power of y for values of any float type, computed in float64.
+func pow[T Float](x, y T) T {
+	return T(math.Pow(float64(x), float64(y)))
+}
+
+// atan2 returns the arc tangent of y/x for values of any float type, computed in float64.
+func atan2[T Float](y, x T) T {
+	return T(math.Atan2(float64(y), float64(x)))
+}
+
+// acos returns the arc cosine of a value of any float type, computed in float64.
+func acos[T Float](x T) T {
+	return T(math.Acos(float64(x)))
+}
+
+// sin returns the sine of a value of any float type, computed in float64.
+func sin[T Float](x T) T {
+	return T(math.Sin(float64(x)))
+}
+
+// cos returns the cosine of a value of any float type, computed in float64.
+func cos[T Float](x T) T {
+	return T(math.Cos(float64(x)))
+}
+
+// sincos returns the sine and cosine of a value of any float type, computed in float64.
+func sincos[T Float](x T) (T, T) {
+	sin, cos := math.Sincos(float64(x))
+
+	return T(sin), T(cos)
+}
+
+// hypot returns sqrt(x*x + y*y) for values of any float type, avoiding overflow and underflow.
+func hypot[T Float](x, y T) T {
+	return T(math.Hypot(float64(x), float64(y)))
+}
+
+// isNaN checks if a value of any float type is NaN.
+func isNaN[T Float](x T) bool {
+	return x != x
+}
+
+// isInf checks if a value of any float type is positive or negative infinity.
+func isInf[T Float](x T) bool {
+	return math.IsInf(float64(x), 0)
+}
@@ -0,0 +1,13 @@
+package vectors
+
+import (
+	"math"
+)
+
+// testEpsilon is the tolerance used by tests when comparing floating-point results.
+const testEpsilon = 1e-9
+
+// approxEqual checks if two floats differ by less than epsilon.
+func approxEqual(a, b, epsilon float64) bool {
+	return math.Abs(a-b) < epsilon
+}
@@ -0,0 +1,78 @@
+//go:build yaml
+
+package vectors
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// This file is only built with the "yaml" build tag,
+// so the YAML dependency is not pulled in for users who don't need it.
+
+type vec2YAML[T Float] struct {
+	X T `yaml:"x"`
+	Y T `yaml:"y"`
+}
+
+type vec3YAML[T Float] struct {
+	X T `yaml:"x"`
+	Y T `yaml:"y"`
+	Z T `yaml:"z"`
+}
+
+var (
+	_ yaml.Marshaler   = Vec2[float64]{}
+	_ yaml.Unmarshaler = (*Vec2[float64])(nil)
+	_ yaml.Marshaler   = Vec3[float64]{}
+	_ yaml.Unmarshaler = (*Vec3[float64])(nil)
+)
+
+// MarshalYAML encodes the vector as a YAML mapping with "x" and "y" keys.
+func (v Vec2[T]) MarshalYAML() (any, error) {
+	return vec2YAML[T](v), nil
+}
+
+// UnmarshalYAML decodes the vector from a YAML mapping with "x" and "y" keys,
+// or from a compact scalar in any format accepted by ParseVector2, such as "1.5,2.3".
+func (v *Vec2[T]) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		return v.UnmarshalText([]byte(trimVectorDelimiters(value.Value)))
+	}
+
+	var vec vec2YAML[T]
+
+	err := value.Decode(&vec)
+
+	if err != nil {
+		return err
+	}
+
+	*v = Vec2[T](vec)
+
+	return nil
+}
+
+// MarshalYAML encodes the vector as a YAML mapping with "x", "y", and "z" keys.
+func (v Vec3[T]) MarshalYAML() (any, error) {
+	return vec3YAML[T](v), nil
+}
+
+// UnmarshalYAML decodes the vector from a YAML mapping with "x", "y", and "z" keys,
+// or from a compact scalar in any format accepted by ParseVector3, such as "1.5,2.3,4".
+func (v *Vec3[T]) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		return v.UnmarshalText([]byte(trimVectorDelimiters(value.Value)))
+	}
+
+	var vec vec3YAML[T]
+
+	err := value.Decode(&vec)
+
+	if err != nil {
+		return err
+	}
+
+	*v = Vec3[T](vec)
+
+	return nil
+}
@@ -0,0 +1,164 @@
+//go:build yaml
+
+package vectors
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestVector2YAMLRoundTrip(t *testing.T) {
+	for _, v := range []Vector2{{X: 0, Y: 0}, {X: 1.5, Y: -2.25}, {X: 1e21, Y: 5e-324}} {
+		data, err := yaml.Marshal(v)
+
+		if err != nil {
+			t.Fatalf("yaml.Marshal(%v) returned an error: %v", v, err)
+		}
+
+		var got Vector2
+
+		err = yaml.Unmarshal(data, &got)
+
+		if err != nil {
+			t.Fatalf("yaml.Unmarshal(%q) returned an error: %v", data, err)
+		}
+
+		if !got.Equal(v) {
+			t.Errorf("YAML round trip of %v through %q = %v", v, data, got)
+		}
+	}
+}
+
+func TestVector2MarshalYAML(t *testing.T) {
+	data, err := yaml.Marshal(Vector2{X: 1.5, Y: -2})
+
+	if err != nil {
+		t.Fatalf("yaml.Marshal() returned an error: %v", err)
+	}
+
+	// The YAML encoder quotes the "y" key, since a bare y is a boolean in YAML 1.1.
+	if want := "x: 1.5\n\"y\": -2\n"; string(data) != want {
+		t.Errorf("yaml.Marshal() = %q, want %q", data, want)
+	}
+}
+
+func TestVector2UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Vector2
+	}{
+		{"mapping", "x: 1.5\ny: -2", Vector2{X: 1.5, Y: -2}},
+		{"flow mapping", "{x: 3, y: 4}", Vector2{X: 3, Y: 4}},
+		{"missing key", "x: 7", Vector2{X: 7, Y: 0}},
+		{"scalar", "1.5,2.3", Vector2{X: 1.5, Y: 2.3}},
+		{"quoted scalar with parentheses", `"(1, -1)"`, Vector2{X: 1, Y: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Vector2
+
+			err := yaml.Unmarshal([]byte(tt.input), &got)
+
+			if err != nil {
+				t.Fatalf("yaml.Unmarshal(%q) returned an error: %v", tt.input, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("yaml.Unmarshal(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector2UnmarshalYAMLError(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantText string
+	}{
+		{"too few components", "1.5", "expected 2 components"},
+		{"invalid component", `"(1, b)"`, "Y component"},
+		{"invalid mapping value", "x: a\ny: 2", "cannot unmarshal"},
+		{"sequence", "[1, 2]", "cannot unmarshal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Vector2
+
+			err := yaml.Unmarshal([]byte(tt.input), &got)
+
+			if err == nil {
+				t.Fatalf("yaml.Unmarshal(%q) returned no error", tt.input)
+			}
+
+			if !strings.Contains(err.Error(), tt.wantText) {
+				t.Errorf("yaml.Unmarshal(%q) error = %q, want it to contain %q", tt.input, err, tt.wantText)
+			}
+		})
+	}
+}
+
+func TestVector3YAMLRoundTrip(t *testing.T) {
+	for _, v := range []Vector3{{X: 0, Y: 0, Z: 0}, {X: 1.5, Y: -2.25, Z: 3}, {X: -1e-7, Y: 1e21, Z: 0.1}} {
+		data, err := yaml.Marshal(v)
+
+		if err != nil {
+			t.Fatalf("yaml.Marshal(%v) returned an error: %v", v, err)
+		}
+
+		var got Vector3
+
+		err = yaml.Unmarshal(data, &got)
+
+		if err != nil {
+			t.Fatalf("yaml.Unmarshal(%q) returned an error: %v", data, err)
+		}
+
+		if !got.Equal(v) {
+			t.Errorf("YAML round trip of %v through %q = %v", v, data, got)
+		}
+	}
+}
+
+func TestVector3UnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  Vector3
+	}{
+		{"mapping", "x: 1\ny: 2\nz: 3", Vector3{X: 1, Y: 2, Z: 3}},
+		{"scalar", "1.5,2.3,4", Vector3{X: 1.5, Y: 2.3, Z: 4}},
+		{"quoted scalar with brackets", `"[0, 0, -1]"`, Vector3{X: 0, Y: 0, Z: -1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Vector3
+
+			err := yaml.Unmarshal([]byte(tt.input), &got)
+
+			if err != nil {
+				t.Fatalf("yaml.Unmarshal(%q) returned an error: %v", tt.input, err)
+			}
+
+			if !got.Equal(tt.want) {
+				t.Errorf("yaml.Unmarshal(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVector3UnmarshalYAMLError(t *testing.T) {
+	var got Vector3
+
+	err := yaml.Unmarshal([]byte("1, 2"), &got)
+
+	if err == nil || !strings.Contains(err.Error(), "expected 3 components") {
+		t.Errorf("yaml.Unmarshal() error = %v, want it to mention 3 components", err)
+	}
+}